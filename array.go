@@ -2,10 +2,13 @@ package gosch
 
 import "reflect"
 
+type ArrayRule func(value reflect.Value) error
+
 type ArraySchema struct {
 	nilable bool
 	element Schema
 	length  int
+	rules   []ArrayRule
 }
 
 // Array validate data type of the input.
@@ -15,6 +18,7 @@ func Array() ArraySchema {
 		nilable: false,
 		element: nil,
 		length:  0,
+		rules:   []ArrayRule{},
 	}
 }
 
@@ -79,6 +83,16 @@ func (arraySchema ArraySchema) Validate(value any) error {
 		}
 	}
 
+	for _, rule := range arraySchema.rules {
+		if err := rule(reflectedValue); err != nil {
+			return err
+		}
+	}
+
+	if arraySchema.element == nil {
+		return nil
+	}
+
 	i := 0
 	for _, element := range reflectedValue.Seq2() {
 		if err := arraySchema.element.Validate(element.Interface()); err != nil {
@@ -0,0 +1,149 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+// CardBrand is the brand of a payment card, detected from the issuer identification number.
+type CardBrand uint
+
+const (
+	// CardUnknown is a card number that pass the Luhn check but has no known brand.
+	CardUnknown CardBrand = iota
+	CardVisa
+	CardMastercard
+	CardAmex
+	CardDiscover
+	CardJCB
+	CardDinersClub
+	CardUnionPay
+	CardMaestro
+)
+
+func (brand CardBrand) String() string {
+	switch brand {
+	case CardVisa:
+		return "Visa"
+	case CardMastercard:
+		return "Mastercard"
+	case CardAmex:
+		return "American Express"
+	case CardDiscover:
+		return "Discover"
+	case CardJCB:
+		return "JCB"
+	case CardDinersClub:
+		return "Diners Club"
+	case CardUnionPay:
+		return "UnionPay"
+	case CardMaestro:
+		return "Maestro"
+	default:
+		return "unknown"
+	}
+}
+
+// cardRange is an inclusive range of issuer identification number prefixes of a brand.
+type cardRange struct {
+	brand   CardBrand
+	low     string
+	high    string
+	lengths []int
+}
+
+// cardRanges is checked in order, so the narrower ranges come before the wider ones, e.g. Discover's 622126 before UnionPay's 62.
+var cardRanges = []cardRange{
+	{brand: CardAmex, low: "34", high: "34", lengths: []int{15}},
+	{brand: CardAmex, low: "37", high: "37", lengths: []int{15}},
+	{brand: CardDinersClub, low: "300", high: "305", lengths: []int{14, 15, 16, 17, 18, 19}},
+	{brand: CardDinersClub, low: "36", high: "36", lengths: []int{14, 15, 16, 17, 18, 19}},
+	{brand: CardDinersClub, low: "38", high: "39", lengths: []int{16, 17, 18, 19}},
+	{brand: CardJCB, low: "3528", high: "3589", lengths: []int{16, 17, 18, 19}},
+	{brand: CardVisa, low: "4", high: "4", lengths: []int{13, 16, 19}},
+	{brand: CardMaestro, low: "5018", high: "5018", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMaestro, low: "5020", high: "5020", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMaestro, low: "5038", high: "5038", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMaestro, low: "5893", high: "5893", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMastercard, low: "51", high: "55", lengths: []int{16}},
+	{brand: CardMastercard, low: "2221", high: "2720", lengths: []int{16}},
+	{brand: CardDiscover, low: "6011", high: "6011", lengths: []int{16, 17, 18, 19}},
+	{brand: CardDiscover, low: "622126", high: "622925", lengths: []int{16, 17, 18, 19}},
+	{brand: CardDiscover, low: "644", high: "649", lengths: []int{16, 17, 18, 19}},
+	{brand: CardDiscover, low: "65", high: "65", lengths: []int{16, 17, 18, 19}},
+	{brand: CardUnionPay, low: "62", high: "62", lengths: []int{16, 17, 18, 19}},
+	{brand: CardMaestro, low: "6304", high: "6304", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMaestro, low: "6759", high: "6759", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMaestro, low: "6761", high: "6763", lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// LuhnCard validate that a string is a payment card number that pass the Luhn check, spaces and hyphens between the digits are ignored.
+// The brand must be one of the brands, or any brand including CardUnknown if there are none.
+// If the input is not a valid card number, it will return an error.
+func (stringSchema StringSchema) LuhnCard(brands ...CardBrand) StringSchema {
+	brands = slices.Clone(brands)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseCard(value, brands)
+		return err
+	})
+
+	return stringSchema
+}
+
+// ParseCard validate the card number like the LuhnCard rule and return its brand.
+func ParseCard(value string, brands ...CardBrand) (CardBrand, error) {
+	return parseCard(value, brands)
+}
+
+func parseCard(value string, brands []CardBrand) (CardBrand, error) {
+	ruleError := RuleError{
+		Name:   RuleCard,
+		Value:  value,
+		Params: []any{brands},
+	}
+
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(digits) < 12 || len(digits) > 19 || strings.Trim(digits, "0123456789") != "" || !luhn(digits) {
+		return CardUnknown, ruleError
+	}
+
+	brand := cardBrandOf(digits)
+	if len(brands) > 0 && !slices.Contains(brands, brand) {
+		return brand, ruleError
+	}
+
+	return brand, nil
+}
+
+func cardBrandOf(digits string) CardBrand {
+	for _, cardRange := range cardRanges {
+		prefix := digits[:len(cardRange.low)]
+		if prefix < cardRange.low || prefix > cardRange.high {
+			continue
+		}
+
+		if slices.Contains(cardRange.lengths, len(digits)) {
+			return cardRange.brand
+		}
+	}
+
+	return CardUnknown
+}
+
+// luhn validate the check digit of a string of digits with the Luhn algorithm.
+func luhn(digits string) bool {
+	sum := 0
+	for i := range len(digits) {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
@@ -0,0 +1,78 @@
+package gosch
+
+import "testing"
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		value string
+		want  CardBrand
+	}{
+		{value: "4111111111111111", want: CardVisa},
+		{value: "4111 1111 1111 1111", want: CardVisa},
+		{value: "4111-1111-1111-1111", want: CardVisa},
+		{value: "4000000000000000006", want: CardVisa},
+		{value: "5555555555554444", want: CardMastercard},
+		{value: "2221000000000009", want: CardMastercard},
+		{value: "2720000000000005", want: CardMastercard},
+		{value: "2721000000000004", want: CardUnknown},
+		{value: "378282246310005", want: CardAmex},
+		{value: "371449635398431", want: CardAmex},
+		{value: "6011111111111117", want: CardDiscover},
+		{value: "6440000000000005", want: CardDiscover},
+		{value: "6500000000000002", want: CardDiscover},
+		{value: "6221260000000000", want: CardDiscover},
+		{value: "6229250000000003", want: CardDiscover},
+		{value: "6221250000000001", want: CardUnionPay},
+		{value: "6229260000000002", want: CardUnionPay},
+		{value: "6200000000000005", want: CardUnionPay},
+		{value: "3530111333300000", want: CardJCB},
+		{value: "3528000000000007", want: CardJCB},
+		{value: "3589000000000003", want: CardJCB},
+		{value: "30569309025904", want: CardDinersClub},
+		{value: "30000000000004", want: CardDinersClub},
+		{value: "6759649826438453", want: CardMaestro},
+		{value: "6304000000000000", want: CardMaestro},
+		{value: "5018000000007", want: CardMaestro},
+		{value: "9999999999999995", want: CardUnknown},
+		{value: "40000000000000006", want: CardUnknown},
+	}
+
+	for _, test := range tests {
+		got, err := ParseCard(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("ParseCard(%q) = %v, %v, want %v", test.value, got, err, test.want)
+		}
+	}
+}
+
+func TestParseCardInvalid(t *testing.T) {
+	tests := []struct {
+		value  string
+		brands []CardBrand
+	}{
+		{value: "4111111111111112"},
+		{value: "378282246310006"},
+		{value: "41111111111"},
+		{value: "41111111111111111111"},
+		{value: "4111a11111111111"},
+		{value: "4111_1111_1111_1111"},
+		{value: ""},
+		{value: "4111111111111111", brands: []CardBrand{CardMastercard}},
+		{value: "9999999999999995", brands: []CardBrand{CardVisa, CardMastercard}},
+	}
+
+	for _, test := range tests {
+		if got, err := ParseCard(test.value, test.brands...); err == nil {
+			t.Errorf("ParseCard(%q, %v) = %v, want an error", test.value, test.brands, got)
+		}
+	}
+}
+
+func TestCardBrandString(t *testing.T) {
+	if got := CardAmex.String(); got != "American Express" {
+		t.Errorf("CardAmex.String() = %q, want %q", got, "American Express")
+	}
+	if got := CardUnknown.String(); got != "unknown" {
+		t.Errorf("CardUnknown.String() = %q, want %q", got, "unknown")
+	}
+}
@@ -0,0 +1,134 @@
+package gosch
+
+import (
+	"slices"
+	"unicode"
+)
+
+// ASCII validate that a string contain only ASCII characters.
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) ASCII() StringSchema {
+	return stringSchema.charset(RuleASCII, func(r rune) bool {
+		return r <= unicode.MaxASCII
+	})
+}
+
+// Printable validate that a string contain only printable characters as defined by unicode.IsPrint,
+// letters, marks, numbers, punctuation, symbols and the ASCII space.
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) Printable() StringSchema {
+	return stringSchema.charset(RulePrintable, unicode.IsPrint)
+}
+
+// Alpha validate that a string contain only Unicode letters, combine it with ASCII to accept only "a" to "z" and "A" to "Z".
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) Alpha() StringSchema {
+	return stringSchema.charset(RuleAlpha, unicode.IsLetter)
+}
+
+// Alphanumeric validate that a string contain only Unicode letters and decimal digits.
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) Alphanumeric() StringSchema {
+	return stringSchema.charset(RuleAlphanumeric, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+// Numeric validate that a string contain only Unicode decimal digits.
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) Numeric() StringSchema {
+	return stringSchema.charset(RuleNumeric, unicode.IsDigit)
+}
+
+// Lowercase validate that a string contain no uppercase letters, other characters such as digits are accepted.
+// If the input contain an uppercase letter, it will return an error with the letter and its position.
+func (stringSchema StringSchema) Lowercase() StringSchema {
+	return stringSchema.charset(RuleLowercase, func(r rune) bool {
+		return !unicode.IsUpper(r)
+	})
+}
+
+// Uppercase validate that a string contain no lowercase letters, other characters such as digits are accepted.
+// If the input contain a lowercase letter, it will return an error with the letter and its position.
+func (stringSchema StringSchema) Uppercase() StringSchema {
+	return stringSchema.charset(RuleUppercase, func(r rune) bool {
+		return !unicode.IsLower(r)
+	})
+}
+
+// NoControlChars validate that a string contain no control characters (unicode.Cc), including tabs and line breaks.
+// If the input contain a control character, it will return an error with the character and its position.
+func (stringSchema StringSchema) NoControlChars() StringSchema {
+	return stringSchema.charset(RuleControlChars, func(r rune) bool {
+		return !unicode.IsControl(r)
+	})
+}
+
+// Charset validate that a string contain only characters of the tables, e.g. unicode.Latin and unicode.Digit.
+// It will panic if the tables are empty.
+// If the input contain another character, it will return an error with the character and its position.
+func (stringSchema StringSchema) Charset(tables ...*unicode.RangeTable) StringSchema {
+	if len(tables) == 0 {
+		panic("charset tables must not be empty")
+	}
+
+	tables = slices.Clone(tables)
+
+	return stringSchema.charset(RuleCharset, func(r rune) bool {
+		return unicode.In(r, tables...)
+	})
+}
+
+// Slug validate that a string is a URL slug, lowercase ASCII letters and digits separated by single hyphens, e.g. "hello-world-2".
+// Like the other character class rules, it accept an empty string, combine it with NotEmpty to reject one.
+// If the input contain another character, or a hyphen at the start, at the end or after another hyphen,
+// it will return an error with the character and its position.
+func (stringSchema StringSchema) Slug() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		position := 0
+		previous := '-'
+
+		for _, r := range value {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			case r == '-' && previous != '-':
+			default:
+				return charsetError(RuleSlug, value, r, position)
+			}
+
+			previous = r
+			position++
+		}
+
+		if previous == '-' && position > 0 {
+			return charsetError(RuleSlug, value, previous, position-1)
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+func (stringSchema StringSchema) charset(name RuleName, allowed func(r rune) bool) StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		position := 0
+		for _, r := range value {
+			if !allowed(r) {
+				return charsetError(name, value, r, position)
+			}
+			position++
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// charsetError report the first offending rune and its position, counted in runes from 0.
+func charsetError(name RuleName, value string, r rune, position int) error {
+	return RuleError{
+		Name:   name,
+		Value:  value,
+		Params: []any{r, position},
+	}
+}
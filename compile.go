@@ -0,0 +1,110 @@
+package gosch
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Compile return a copy of the schema with its own reflection plan cache per concrete Go type,
+// not shared with the schemas it was copied from.
+// The nested schemas of structs, arrays, slices, maps and the Text and Valuer wrappers are compiled too.
+// A compiled schema is safe for concurrent use.
+func Compile(schema Schema) Schema {
+	switch schema := schema.(type) {
+	case StructSchema:
+		fields := make([]structField, len(schema.fields))
+		for i, field := range schema.fields {
+			fields[i] = structField{
+				name:   field.name,
+				schema: Compile(field.schema),
+			}
+		}
+
+		schema.fields = fields
+		schema.plans = &sync.Map{}
+
+		return schema
+	case ArraySchema:
+		schema.element = Compile(schema.element)
+
+		return schema
+	case SliceSchema:
+		schema.element = Compile(schema.element)
+
+		return schema
+	case TextSchema:
+		schema.schema = Compile(schema.schema)
+
+		return schema
+	case ValuerSchema:
+		schema.schema = Compile(schema.schema)
+
+		return schema
+	case MapSchema:
+		schema.key = Compile(schema.key)
+		schema.element = Compile(schema.element)
+
+		return schema
+	default:
+		return schema
+	}
+}
+
+// TypedSchema is a compiled schema bound to the Go type T.
+type TypedSchema[T any] struct {
+	schema Schema
+}
+
+// For compile the schema and resolve its reflection plan for the Go type T ahead of validation.
+func For[T any](schema Schema) TypedSchema[T] {
+	schema = Compile(schema)
+
+	resolve(schema, reflect.TypeFor[T]())
+
+	return TypedSchema[T]{
+		schema: schema,
+	}
+}
+
+func (typedSchema TypedSchema[T]) Validate(value T) error {
+	return typedSchema.schema.Validate(value)
+}
+
+// resolve store the reflection plan of the given type in every compiled schema.
+func resolve(schema Schema, reflectedType reflect.Type) {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+
+	switch schema := schema.(type) {
+	case StructSchema:
+		if reflectedType.Kind() != reflect.Struct {
+			return
+		}
+
+		for i, index := range schema.plan(reflectedType) {
+			if index != nil {
+				resolve(schema.fields[i].schema, reflectedType.FieldByIndex(index).Type)
+			}
+		}
+	case ArraySchema:
+		if reflectedType.Kind() == reflect.Array {
+			resolve(schema.element, reflectedType.Elem())
+		}
+	case SliceSchema:
+		if reflectedType.Kind() == reflect.Slice {
+			resolve(schema.element, reflectedType.Elem())
+		}
+	case MapSchema:
+		if reflectedType.Kind() == reflect.Map {
+			resolve(schema.key, reflectedType.Key())
+			resolve(schema.element, reflectedType.Elem())
+		}
+	case TextSchema:
+		// A type that is not a text marshaler or a stringer is passed to the schema unchanged.
+		resolve(schema.schema, reflectedType)
+	case ValuerSchema:
+		// A type that is not a driver.Valuer is passed to the schema unchanged.
+		resolve(schema.schema, reflectedType)
+	}
+}
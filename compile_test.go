@@ -0,0 +1,131 @@
+package gosch
+
+import (
+	"reflect"
+	"testing"
+)
+
+type compileInner struct {
+	A int
+}
+
+type compileOuter struct {
+	Inner compileInner
+	Items []compileInner
+}
+
+func TestStructPlanCache(t *testing.T) {
+	schema := Struct().Field("A", Int())
+	innerType := reflect.TypeFor[compileInner]()
+
+	if err := schema.Validate(compileInner{A: 1}); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+	if _, ok := schema.plans.Load(innerType); !ok {
+		t.Error("plan is not cached after Validate")
+	}
+
+	derived := schema.Field("B", Int())
+	if _, ok := derived.plans.Load(innerType); ok {
+		t.Error("derived schema reuse the plan of the previous fields")
+	}
+
+	compiled := Compile(schema).(StructSchema)
+	if compiled.plans == schema.plans {
+		t.Error("compiled schema share the plan cache of its source")
+	}
+}
+
+func TestForResolve(t *testing.T) {
+	inner := Struct().Field("A", Int().MinValue(1))
+
+	typed := For[compileOuter](Struct().
+		Field("Inner", Valuer(Text(inner))).
+		Field("Items", Slice().Element(inner)))
+
+	outer := typed.schema.(StructSchema)
+	innerType := reflect.TypeFor[compileInner]()
+
+	if _, ok := outer.plans.Load(reflect.TypeFor[compileOuter]()); !ok {
+		t.Error("outer plan is not resolved")
+	}
+
+	wrapped := outer.fields[0].schema.(ValuerSchema).schema.(TextSchema).schema.(StructSchema)
+	if _, ok := wrapped.plans.Load(innerType); !ok {
+		t.Error("plan under Valuer and Text is not resolved")
+	}
+
+	element := outer.fields[1].schema.(SliceSchema).element.(StructSchema)
+	if _, ok := element.plans.Load(innerType); !ok {
+		t.Error("plan of the slice element is not resolved")
+	}
+
+	if err := typed.Validate(compileOuter{Inner: compileInner{A: 0}}); err == nil {
+		t.Error("Validate() = nil, want an error for Inner.A")
+	}
+}
+
+func TestCopyOnWrite(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		base := Int().MinValue(0)
+		maximum := base.MaxValue(10)
+		multiple := base.MultipleOf(7)
+
+		if err := maximum.Validate(5); err != nil {
+			t.Errorf("maximum.Validate(5) = %v, want nil", err)
+		}
+		if err := multiple.Validate(21); err != nil {
+			t.Errorf("multiple.Validate(21) = %v, want nil", err)
+		}
+		if err := base.Validate(11); err != nil {
+			t.Errorf("base.Validate(11) = %v, want nil", err)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		base := String().MinLength(1).MaxLength(10).NotEmpty()
+		prefixed := base.StartsWith("a")
+		suffixed := base.EndsWith("z")
+
+		if err := prefixed.Validate("ab"); err != nil {
+			t.Errorf("prefixed.Validate(ab) = %v, want nil", err)
+		}
+		if err := suffixed.Validate("yz"); err != nil {
+			t.Errorf("suffixed.Validate(yz) = %v, want nil", err)
+		}
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		base := Slice().MinLength(1)
+		short := base.MaxLength(1)
+		long := base.MinLength(3)
+
+		if err := short.Validate([]int{1}); err != nil {
+			t.Errorf("short.Validate([1]) = %v, want nil", err)
+		}
+		if err := long.Validate([]int{1, 2, 3}); err != nil {
+			t.Errorf("long.Validate([1 2 3]) = %v, want nil", err)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		base := Struct().Field("A", Int())
+		withB := base.Field("B", Int())
+		withC := base.Field("C", Int())
+
+		if err := withB.Validate(struct{ A, B int }{}); err != nil {
+			t.Errorf("withB.Validate() = %v, want nil", err)
+		}
+		if err := withC.Validate(struct{ A, C int }{}); err != nil {
+			t.Errorf("withC.Validate() = %v, want nil", err)
+		}
+
+		replaced := base.Field("A", Int().MinValue(1))
+		if err := base.Validate(struct{ A int }{}); err != nil {
+			t.Errorf("base.Validate() = %v, want nil after redeclaring A on a copy", err)
+		}
+		if err := replaced.Validate(struct{ A int }{}); err == nil {
+			t.Error("replaced.Validate() = nil, want an error")
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package gosch
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// validateConcurrently run validate for every index from 0 to count using at most the given number of workers.
+// It returns the error of the lowest failing index, so the result is the same as a sequential validation.
+func validateConcurrently(count int, workers int, validate func(index int) error) error {
+	if workers > count {
+		workers = count
+	}
+
+	var (
+		next      atomic.Int64
+		lowest    atomic.Int64
+		mutex     sync.Mutex
+		failIndex = count
+		failErr   error
+		waitGroup sync.WaitGroup
+	)
+
+	lowest.Store(int64(count))
+
+	waitGroup.Add(workers)
+	for range workers {
+		go func() {
+			defer waitGroup.Done()
+
+			for {
+				index := int(next.Add(1) - 1)
+
+				// Every worker takes increasing indexes, so once an index is past the lowest failure
+				// the remaining ones can not change the result.
+				if index >= count || int64(index) > lowest.Load() {
+					return
+				}
+
+				if err := validate(index); err != nil {
+					mutex.Lock()
+					if index < failIndex {
+						failIndex = index
+						failErr = err
+						lowest.Store(int64(index))
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	return failErr
+}
+
+type mapEntry struct {
+	key     reflect.Value
+	element reflect.Value
+}
+
+// sortedEntries return the entries of a map sorted by key, so the first error does not depend on the map order.
+// The entries are read with a single MapRange, since MapIndex can not find a NaN key.
+func sortedEntries(reflectedValue reflect.Value) []mapEntry {
+	entries := make([]mapEntry, 0, reflectedValue.Len())
+
+	iterator := reflectedValue.MapRange()
+	for iterator.Next() {
+		entries = append(entries, mapEntry{
+			key:     iterator.Key(),
+			element: iterator.Value(),
+		})
+	}
+
+	slices.SortFunc(entries, func(a mapEntry, b mapEntry) int {
+		return compareKeys(a.key, b.key)
+	})
+
+	return entries
+}
+
+func compareKeys(a reflect.Value, b reflect.Value) int {
+	// The keys of a map[any]any are interfaces, compare the values inside them.
+	if a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(a.Int(), b.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(a.Uint(), b.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(a.Float(), b.Float())
+		case reflect.String:
+			return cmp.Compare(a.String(), b.String())
+		case reflect.Bool:
+			switch {
+			case a.Bool() == b.Bool():
+				return 0
+			case !a.Bool():
+				return -1
+			default:
+				return 1
+			}
+		}
+	}
+
+	return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+}
@@ -0,0 +1,108 @@
+package gosch
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestValidateConcurrentlyLowestIndex(t *testing.T) {
+	for _, workers := range []int{2, 4, 16, 100} {
+		for range 50 {
+			// Later indexes fail faster, so the first failure found is not the lowest one.
+			err := validateConcurrently(64, workers, func(index int) error {
+				if index%7 == 3 {
+					time.Sleep(time.Duration(64-index) * time.Microsecond)
+					return fmt.Errorf("index %d", index)
+				}
+				return nil
+			})
+
+			if err == nil || err.Error() != "index 3" {
+				t.Fatalf("workers %d: validateConcurrently() = %v, want index 3", workers, err)
+			}
+		}
+	}
+}
+
+var regexpKey = regexp.MustCompile(`[5-9]$`)
+
+func TestConcurrentMatchSequential(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	elements := make([]int, 500)
+	entries := make(map[string]int, 500)
+	for i := range elements {
+		elements[i] = random.IntN(1000)
+		entries[fmt.Sprintf("key%03d", i)] = elements[i]
+	}
+
+	element := Int().MaxValue(900)
+
+	tests := []struct {
+		name       string
+		sequential Schema
+		concurrent Schema
+		value      any
+	}{
+		{name: "slice", sequential: Slice().Element(element), concurrent: Slice().Element(element).Concurrent(8), value: elements},
+		{name: "map", sequential: Map().Element(element), concurrent: Map().Element(element).Concurrent(8), value: entries},
+		{name: "map keys", sequential: Map().Key(String().NotPattern(regexpKey)), concurrent: Map().Key(String().NotPattern(regexpKey)).Concurrent(8), value: entries},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want := test.sequential.Validate(test.value)
+			if want == nil {
+				t.Fatal("sequential Validate() = nil, want an error")
+			}
+
+			for range 20 {
+				if got := test.sequential.Validate(test.value); got == nil || got.Error() != want.Error() {
+					t.Fatalf("sequential Validate() = %v, want %v", got, want)
+				}
+				if got := test.concurrent.Validate(test.value); got == nil || got.Error() != want.Error() {
+					t.Fatalf("concurrent Validate() = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConcurrentPanic(t *testing.T) {
+	tests := []struct {
+		name       string
+		concurrent func()
+	}{
+		{name: "slice", concurrent: func() { Slice().Concurrent(0) }},
+		{name: "map", concurrent: func() { Map().Concurrent(0) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Concurrent(0) did not panic")
+				}
+			}()
+
+			test.concurrent()
+		})
+	}
+}
+
+func TestConcurrentNoError(t *testing.T) {
+	err := Slice().Element(Int()).Concurrent(4).Validate([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	var elementError ElementError
+	err = Slice().Element(Int().MinValue(2)).Concurrent(4).Validate([]int{1, 2, 3})
+	if !errors.As(err, &elementError) || elementError.Index != 0 {
+		t.Errorf("Validate() = %v, want an error at index 0", err)
+	}
+}
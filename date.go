@@ -0,0 +1,213 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// TimeBound is an inclusive minimum or maximum of the date and time rules.
+// It is either a fixed instant or an offset from the current time.
+type TimeBound struct {
+	instant  time.Time
+	offset   time.Duration
+	relative bool
+}
+
+// At return a bound at a fixed instant.
+func At(instant time.Time) TimeBound {
+	return TimeBound{
+		instant: instant,
+	}
+}
+
+// FromNow return a bound relative to the current time of the clock.
+// FromNow(0) is now, FromNow(-24 * time.Hour) is a day ago.
+func FromNow(offset time.Duration) TimeBound {
+	return TimeBound{
+		offset:   offset,
+		relative: true,
+	}
+}
+
+func (timeBound TimeBound) isZero() bool {
+	return !timeBound.relative && timeBound.instant.IsZero()
+}
+
+func (timeBound TimeBound) resolve(clock func() time.Time) time.Time {
+	if !timeBound.relative {
+		return timeBound.instant
+	}
+
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return clock().Add(timeBound.offset)
+}
+
+// TimeOptions configure the date and time rules and their parse functions.
+type TimeOptions struct {
+	// RequireOffset reject date-times and times without a timezone offset, e.g. "Z" or "+07:00".
+	RequireOffset bool
+	// Precision is the smallest unit the value may use, e.g. time.Second reject fractional seconds.
+	// It is checked on the wall clock of the value, so time.Hour accept "10:00+05:30".
+	// Zero accept any precision.
+	Precision time.Duration
+	// Min is the earliest accepted time.
+	Min TimeBound
+	// Max is the latest accepted time.
+	Max TimeBound
+	// Clock return the current time for the bounds created by FromNow, it default to time.Now.
+	Clock func() time.Time
+}
+
+type timeLayout struct {
+	layout string
+	offset bool
+}
+
+var (
+	dateLayouts = []timeLayout{
+		{layout: time.DateOnly, offset: false},
+	}
+	dateTimeLayouts = []timeLayout{
+		{layout: time.RFC3339Nano, offset: true},
+		{layout: "2006-01-02T15:04:05.999999999", offset: false},
+	}
+	timeLayouts = []timeLayout{
+		{layout: "15:04:05.999999999Z07:00", offset: true},
+		{layout: "15:04:05.999999999", offset: false},
+	}
+)
+
+// Date validate that a string is an ISO 8601 calendar date, e.g. "2006-01-02".
+// If the input is not a valid date, it will return an error.
+func (stringSchema StringSchema) Date(options ...TimeOptions) StringSchema {
+	return stringSchema.time(RuleDate, dateLayouts, firstOption(options))
+}
+
+// DateTime validate that a string is an RFC 3339 date-time, e.g. "2006-01-02T15:04:05Z07:00".
+// The timezone offset is optional unless the options require it.
+// If the input is not a valid date-time, it will return an error.
+func (stringSchema StringSchema) DateTime(options ...TimeOptions) StringSchema {
+	return stringSchema.time(RuleDateTime, dateTimeLayouts, firstOption(options))
+}
+
+// Time validate that a string is an RFC 3339 time, e.g. "15:04:05" or "15:04:05.999Z07:00".
+// The bounds are compared with the time on January 1 of year 0.
+// If the input is not a valid time, it will return an error.
+func (stringSchema StringSchema) Time(options ...TimeOptions) StringSchema {
+	return stringSchema.time(RuleTime, timeLayouts, firstOption(options))
+}
+
+// Layout validate that a string can be parsed by time.Parse with the layout.
+// If the input does not match the layout, it will return an error.
+func (stringSchema StringSchema) Layout(layout string, options ...TimeOptions) StringSchema {
+	return stringSchema.time(RuleLayout, customLayout(layout), firstOption(options))
+}
+
+func (stringSchema StringSchema) time(name RuleName, layouts []timeLayout, options TimeOptions) StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseTime(value, name, layouts, options)
+		return err
+	})
+
+	return stringSchema
+}
+
+// ParseDate validate the string like the Date rule and return the parsed date.
+func ParseDate(value string, options ...TimeOptions) (time.Time, error) {
+	return parseTime(value, RuleDate, dateLayouts, firstOption(options))
+}
+
+// ParseDateTime validate the string like the DateTime rule and return the parsed date-time.
+// A date-time without an offset is in UTC.
+func ParseDateTime(value string, options ...TimeOptions) (time.Time, error) {
+	return parseTime(value, RuleDateTime, dateTimeLayouts, firstOption(options))
+}
+
+// ParseTime validate the string like the Time rule and return the parsed time.
+func ParseTime(value string, options ...TimeOptions) (time.Time, error) {
+	return parseTime(value, RuleTime, timeLayouts, firstOption(options))
+}
+
+// ParseLayout validate the string like the Layout rule and return the parsed time.
+func ParseLayout(layout string, value string, options ...TimeOptions) (time.Time, error) {
+	return parseTime(value, RuleLayout, customLayout(layout), firstOption(options))
+}
+
+func customLayout(layout string) []timeLayout {
+	return []timeLayout{
+		{
+			layout: layout,
+			offset: strings.Contains(layout, "Z07") || strings.Contains(layout, "-07") || strings.Contains(layout, "MST"),
+		},
+	}
+}
+
+// isTruncated check that value is a whole number of precision on the wall clock of its own offset,
+// e.g. "10:00+05:30" is on the hour even though it is 04:30 in UTC.
+func isTruncated(value time.Time, precision time.Duration) bool {
+	_, offset := value.Zone()
+	wall := value.Add(time.Duration(offset) * time.Second)
+
+	return wall.Truncate(precision).Equal(wall)
+}
+
+func parseTime(value string, name RuleName, layouts []timeLayout, options TimeOptions) (time.Time, error) {
+	for _, layout := range layouts {
+		parsed, err := time.Parse(layout.layout, value)
+		if err != nil {
+			continue
+		}
+
+		if options.RequireOffset && !layout.offset && name != RuleDate {
+			return time.Time{}, RuleError{
+				Name:  RuleOffset,
+				Value: value,
+			}
+		}
+
+		if options.Precision > 0 && !isTruncated(parsed, options.Precision) {
+			return time.Time{}, RuleError{
+				Name:   RulePrecision,
+				Value:  value,
+				Params: []any{options.Precision},
+			}
+		}
+
+		if !options.Min.isZero() {
+			if min := options.Min.resolve(options.Clock); parsed.Before(min) {
+				return time.Time{}, RuleError{
+					Name:   RuleMinTime,
+					Value:  value,
+					Params: []any{min},
+				}
+			}
+		}
+
+		if !options.Max.isZero() {
+			if max := options.Max.resolve(options.Clock); parsed.After(max) {
+				return time.Time{}, RuleError{
+					Name:   RuleMaxTime,
+					Value:  value,
+					Params: []any{max},
+				}
+			}
+		}
+
+		return parsed, nil
+	}
+
+	var params []any
+	if name == RuleLayout {
+		params = []any{layouts[0].layout}
+	}
+
+	return time.Time{}, RuleError{
+		Name:   name,
+		Value:  value,
+		Params: params,
+	}
+}
@@ -0,0 +1,30 @@
+package gosch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTimePrecision(t *testing.T) {
+	tests := []struct {
+		value     string
+		precision time.Duration
+		valid     bool
+	}{
+		{value: "2026-01-01T10:00:00Z", precision: time.Hour, valid: true},
+		{value: "2026-01-01T10:00:00+05:30", precision: time.Hour, valid: true},
+		{value: "2026-01-01T10:00:00+05:45", precision: time.Hour, valid: true},
+		{value: "2026-01-01T10:30:00+05:30", precision: time.Hour, valid: false},
+		{value: "2026-01-01T10:30:00+05:30", precision: time.Minute, valid: true},
+		{value: "2026-01-01T00:00:00-03:30", precision: 24 * time.Hour, valid: true},
+		{value: "2026-01-01T10:00:00.5+05:30", precision: time.Second, valid: false},
+		{value: "2026-01-01T10:00:00.5Z", precision: time.Millisecond, valid: true},
+	}
+
+	for _, test := range tests {
+		_, err := ParseDateTime(test.value, TimeOptions{Precision: test.precision})
+		if got := err == nil; got != test.valid {
+			t.Errorf("ParseDateTime(%q, %v) = %v, want valid %v", test.value, test.precision, err, test.valid)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package gosch
+
+import (
+	"reflect"
+	"slices"
+	"time"
+)
+
+type DurationRule func(value time.Duration) error
+
+type DurationSchema struct {
+	nilable bool
+	coerce  bool
+	typ     reflect.Type
+	rules   []DurationRule
+}
+
+// Duration validate data type of the input.
+// If the input is not a time.Duration, it will return an error.
+func Duration() DurationSchema {
+	return DurationSchema{
+		nilable: false,
+		coerce:  false,
+		typ:     nil,
+		rules:   []DurationRule{},
+	}
+}
+
+// Nil will pass nil input.
+func (durationSchema DurationSchema) Nil() DurationSchema {
+	durationSchema.nilable = true
+	return durationSchema
+}
+
+// Coerce will also accept a time.ParseDuration string, e.g. "1h30m", and validate the parsed duration.
+func (durationSchema DurationSchema) Coerce() DurationSchema {
+	durationSchema.coerce = true
+	return durationSchema
+}
+
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[Timeout]() for type Timeout time.Duration, instead of time.Duration.
+// It will panic if the kind of the type is not int64.
+// If the input is of another type, it will return an error.
+func (durationSchema DurationSchema) Type(exact reflect.Type) DurationSchema {
+	if exact == nil || exact.Kind() != reflect.Int64 {
+		panic("duration type must be of kind int64")
+	}
+
+	durationSchema.typ = exact
+	return durationSchema
+}
+
+// MinValue validate the minimum value of a duration.
+// If the input is less than the minimum value, it will return an error.
+func (durationSchema DurationSchema) MinValue(min time.Duration) DurationSchema {
+	durationSchema.rules = append(slices.Clip(durationSchema.rules), func(value time.Duration) error {
+		if value < min {
+			return RuleError{
+				Name:   RuleMinValue,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return durationSchema
+}
+
+// MaxValue validate the maximum value of a duration.
+// If the input is greater than the maximum value, it will return an error.
+func (durationSchema DurationSchema) MaxValue(max time.Duration) DurationSchema {
+	durationSchema.rules = append(slices.Clip(durationSchema.rules), func(value time.Duration) error {
+		if value > max {
+			return RuleError{
+				Name:   RuleMaxValue,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return durationSchema
+}
+
+// Truncated validate that a duration is not more precise than the unit, e.g. Truncated(time.Second) reject "1.5s".
+// It will panic if the unit is not greater than 0.
+// If the input is not a multiple of the unit, it will return an error.
+func (durationSchema DurationSchema) Truncated(unit time.Duration) DurationSchema {
+	if unit <= 0 {
+		panic("duration unit must be greater than 0")
+	}
+
+	durationSchema.rules = append(slices.Clip(durationSchema.rules), func(value time.Duration) error {
+		if value.Truncate(unit) != value {
+			return RuleError{
+				Name:   RulePrecision,
+				Value:  value,
+				Params: []any{unit},
+			}
+		}
+		return nil
+	})
+
+	return durationSchema
+}
+
+func (durationSchema DurationSchema) Validate(value any) error {
+	if durationSchema.coerce {
+		switch stringValue := value.(type) {
+		case string:
+			return durationSchema.validateString(stringValue)
+		case *string:
+			if stringValue != nil {
+				return durationSchema.validateString(*stringValue)
+			}
+		}
+	}
+
+	if durationSchema.typ == nil {
+		switch durationValue := value.(type) {
+		case time.Duration:
+			return durationSchema.validate(durationValue)
+		case *time.Duration:
+			if durationValue != nil {
+				return durationSchema.validate(*durationValue)
+			}
+		}
+	}
+
+	expected := reflect.TypeFor[time.Duration]()
+	if durationSchema.typ != nil {
+		expected = durationSchema.typ
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	reflectedType := reflect.TypeOf(value)
+
+	if reflectedType == nil || (reflectedValue.Kind() == reflect.Ptr && reflectedValue.IsNil()) {
+		if durationSchema.nilable {
+			return nil
+		}
+
+		return TypeError{
+			Expected: expected.String(),
+			Actual:   "nil",
+		}
+	}
+
+	if reflectedValue.Kind() == reflect.Ptr {
+		reflectedValue = reflectedValue.Elem()
+		reflectedType = reflectedType.Elem()
+	}
+
+	if reflectedType.Kind() != reflect.Int64 {
+		return TypeError{
+			Expected: expected.String(),
+			Actual:   reflectedType.Kind().String(),
+		}
+	}
+
+	// An int64 is not a duration by itself, e.g. it could be seconds, so only the expected type is accepted.
+	if reflectedType != expected {
+		return TypeError{
+			Expected: expected.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return durationSchema.validate(time.Duration(reflectedValue.Int()))
+}
+
+func (durationSchema DurationSchema) validateString(value string) error {
+	durationValue, err := time.ParseDuration(value)
+	if err != nil {
+		return RuleError{
+			Name:  RuleDuration,
+			Value: value,
+		}
+	}
+
+	return durationSchema.validate(durationValue)
+}
+
+func (durationSchema DurationSchema) validate(value time.Duration) error {
+	for _, rule := range durationSchema.rules {
+		if err := rule(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package gosch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testTimeout time.Duration
+
+func TestDurationType(t *testing.T) {
+	duration := time.Second
+	timeout := testTimeout(time.Second)
+
+	tests := []struct {
+		name   string
+		schema DurationSchema
+		value  any
+		valid  bool
+	}{
+		{name: "duration", schema: Duration(), value: time.Second, valid: true},
+		{name: "duration pointer", schema: Duration(), value: &duration, valid: true},
+		{name: "int64", schema: Duration(), value: int64(1000), valid: false},
+		{name: "int", schema: Duration(), value: 1000, valid: false},
+		{name: "named type", schema: Duration(), value: timeout, valid: false},
+		{name: "named type with Type", schema: Duration().Type(reflect.TypeFor[testTimeout]()), value: timeout, valid: true},
+		{name: "named type pointer with Type", schema: Duration().Type(reflect.TypeFor[testTimeout]()), value: &timeout, valid: true},
+		{name: "int64 with Type", schema: Duration().Type(reflect.TypeFor[testTimeout]()), value: int64(1000), valid: false},
+		{name: "duration with Type", schema: Duration().Type(reflect.TypeFor[testTimeout]()), value: time.Second, valid: false},
+		{name: "int64 with Coerce", schema: Duration().Coerce(), value: int64(1000), valid: false},
+		{name: "named type with Coerce", schema: Duration().Coerce(), value: timeout, valid: false},
+		{name: "string with Type and Coerce", schema: Duration().Type(reflect.TypeFor[testTimeout]()).Coerce(), value: "1s", valid: true},
+		{name: "string with Coerce", schema: Duration().Coerce(), value: "1m30s", valid: true},
+		{name: "nil", schema: Duration(), value: nil, valid: false},
+		{name: "nil with Nil", schema: Duration().Nil(), value: (*time.Duration)(nil), valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestDurationTypeError(t *testing.T) {
+	err := Duration().Coerce().Validate(int64(5))
+
+	typeError, ok := err.(TypeError)
+	if !ok || typeError.Expected != "time.Duration" || typeError.Actual != "int64" {
+		t.Errorf("Validate(int64(5)) = %v, want a time.Duration type error", err)
+	}
+
+	err = Duration().Type(reflect.TypeFor[testTimeout]()).Validate(time.Second)
+
+	typeError, ok = err.(TypeError)
+	if !ok || typeError.Expected != "gosch.testTimeout" || typeError.Actual != "time.Duration" {
+		t.Errorf("Validate(time.Second) = %v, want a gosch.testTimeout type error", err)
+	}
+}
@@ -0,0 +1,176 @@
+package gosch
+
+import (
+	"net/mail"
+	"net/netip"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// EmailOptions configure the Email rule and ParseEmail.
+// The zero value accept a bare RFC 5322 addr-spec with an ASCII domain name.
+type EmailOptions struct {
+	// AllowDisplayName accept the "Name <local@domain>" form.
+	AllowDisplayName bool
+	// RequireTLD reject domains without a top-level domain, e.g. "local@localhost".
+	RequireTLD bool
+	// AllowIPLiteral accept IP address literal domains, e.g. "local@[192.0.2.1]" or "local@[IPv6:2001:db8::1]".
+	AllowIPLiteral bool
+	// AllowIDN accept internationalized domain names, e.g. "local@bücher.example".
+	AllowIDN bool
+}
+
+// Email validate that a string is an email address as defined by the RFC 5322 addr-spec.
+// If the input is not a valid email address, it will return an error.
+func (stringSchema StringSchema) Email(options ...EmailOptions) StringSchema {
+	emailOptions := firstOption(options)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseEmail(value, emailOptions)
+		return err
+	})
+
+	return stringSchema
+}
+
+// ParseEmail validate the email address like the Email rule and return its canonical form.
+// The canonical form is the bare addr-spec with a lowercase domain, e.g. "Name <Local@Example.COM>" become "Local@example.com".
+func ParseEmail(value string, options ...EmailOptions) (string, error) {
+	return parseEmail(value, firstOption(options))
+}
+
+func parseEmail(value string, options EmailOptions) (string, error) {
+	ruleError := RuleError{
+		Name:  RuleEmail,
+		Value: value,
+	}
+
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return "", ruleError
+	}
+
+	at := strings.LastIndexByte(address.Address, '@')
+	if at < 0 {
+		return "", ruleError
+	}
+
+	local := address.Address[:at]
+	domain := strings.ToLower(address.Address[at+1:])
+
+	if !isDotAtom(local) {
+		local = quoteLocal(local)
+	}
+
+	canonical := local + "@" + domain
+
+	// ParseAddress also accept the "Name <local@domain>" and "<local@domain>" forms.
+	if !options.AllowDisplayName && (address.Name != "" || strings.HasSuffix(value, ">") || value != strings.TrimSpace(value)) {
+		return "", ruleError
+	}
+
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		literal, ok := parseIPLiteral(domain[1 : len(domain)-1])
+		if !options.AllowIPLiteral || !ok {
+			return "", ruleError
+		}
+
+		return local + "@[" + literal + "]", nil
+	}
+
+	if !isHostname(domain, options.AllowIDN) {
+		return "", ruleError
+	}
+
+	if options.RequireTLD && !strings.Contains(domain, ".") {
+		return "", ruleError
+	}
+
+	return canonical, nil
+}
+
+// parseIPLiteral validate the content of an RFC 5321 address literal and return its canonical form.
+func parseIPLiteral(literal string) (string, bool) {
+	if len(literal) > 5 && strings.EqualFold(literal[:5], "IPv6:") {
+		address, err := netip.ParseAddr(literal[5:])
+		if err != nil || !address.Is6() || address.Zone() != "" {
+			return "", false
+		}
+		return "IPv6:" + address.String(), true
+	}
+
+	address, err := netip.ParseAddr(literal)
+	if err != nil || !address.Is4() {
+		return "", false
+	}
+	return address.String(), true
+}
+
+// isHostname validate a domain name as defined by RFC 1123.
+// Labels with non-ASCII letters and digits are only accepted when idn is true.
+func isHostname(hostname string, idn bool) bool {
+	if hostname == "" || len(hostname) > 253 {
+		return false
+	}
+
+	labels := strings.Split(hostname, ".")
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			case idn && r > unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)):
+			default:
+				return false
+			}
+		}
+	}
+
+	// An all-numeric top-level domain would be confused with an IPv4 address.
+	return strings.Trim(labels[len(labels)-1], "0123456789") != ""
+}
+
+// isDotAtom validate the unquoted form of an RFC 5322 local part.
+func isDotAtom(local string) bool {
+	for atom := range strings.SplitSeq(local, ".") {
+		if atom == "" {
+			return false
+		}
+
+		for _, r := range atom {
+			if r <= ' ' || r == 0x7f || strings.ContainsRune(`"(),:;<>@[\]`, r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func quoteLocal(local string) string {
+	var builder strings.Builder
+
+	builder.WriteByte('"')
+	for _, r := range local {
+		if r == '"' || r == '\\' {
+			builder.WriteByte('\\')
+		}
+		builder.WriteRune(r)
+	}
+	builder.WriteByte('"')
+
+	return builder.String()
+}
+
+// firstOption return the first of the optional options, or the zero value.
+func firstOption[T any](options []T) T {
+	var option T
+	if len(options) > 0 {
+		option = options[0]
+	}
+	return option
+}
@@ -0,0 +1,72 @@
+package gosch
+
+import "testing"
+
+func TestParseEmail(t *testing.T) {
+	tests := []struct {
+		value   string
+		options EmailOptions
+		want    string
+	}{
+		{value: "local@example.com", want: "local@example.com"},
+		{value: "Local@Example.COM", want: "Local@example.com"},
+		{value: "first.last+tag@sub.example.co.id", want: "first.last+tag@sub.example.co.id"},
+		{value: "local@localhost", want: "local@localhost"},
+		{value: "local@localhost", options: EmailOptions{RequireTLD: true}, want: ""},
+		{value: `"john doe"@example.com`, want: `"john doe"@example.com`},
+		{value: `"john\"doe"@example.com`, want: `"john\"doe"@example.com`},
+		{value: `"simple"@example.com`, want: "simple@example.com"},
+		{value: "Name <local@example.com>", want: ""},
+		{value: "Name <Local@Example.com>", options: EmailOptions{AllowDisplayName: true}, want: "Local@example.com"},
+		{value: "<local@example.com>", want: ""},
+		{value: "<local@example.com>", options: EmailOptions{AllowDisplayName: true}, want: "local@example.com"},
+		{value: " local@example.com", want: ""},
+		{value: "local@[192.0.2.1]", want: ""},
+		{value: "local@[192.0.2.1]", options: EmailOptions{AllowIPLiteral: true}, want: "local@[192.0.2.1]"},
+		{value: "local@[IPv6:2001:DB8:0:0:0:0:0:1]", options: EmailOptions{AllowIPLiteral: true}, want: "local@[IPv6:2001:db8::1]"},
+		{value: "local@[2001:db8::1]", options: EmailOptions{AllowIPLiteral: true}, want: ""},
+		{value: "local@[IPv6:192.0.2.1]", options: EmailOptions{AllowIPLiteral: true}, want: ""},
+		{value: "local@[999.0.2.1]", options: EmailOptions{AllowIPLiteral: true}, want: ""},
+		{value: "local@bücher.example", want: ""},
+		{value: "local@bücher.example", options: EmailOptions{AllowIDN: true}, want: "local@bücher.example"},
+		{value: "local@example.123", want: ""},
+		{value: "local@-example.com", want: ""},
+		{value: "local@example..com", want: ""},
+		{value: ".local@example.com", want: ""},
+		{value: "local.@example.com", want: ""},
+		{value: "lo..cal@example.com", want: ""},
+		{value: "local@", want: ""},
+		{value: "@example.com", want: ""},
+		{value: "local", want: ""},
+		{value: "a@b@example.com", want: ""},
+		{value: "", want: ""},
+	}
+
+	for _, test := range tests {
+		got, err := ParseEmail(test.value, test.options)
+
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("ParseEmail(%q, %+v) = %q, want an error", test.value, test.options, got)
+			}
+			continue
+		}
+
+		if err != nil || got != test.want {
+			t.Errorf("ParseEmail(%q, %+v) = %q, %v, want %q", test.value, test.options, got, err, test.want)
+		}
+	}
+}
+
+func TestEmailRule(t *testing.T) {
+	schema := String().Email(EmailOptions{RequireTLD: true})
+
+	if err := schema.Validate("local@example.com"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "local@example.com", err)
+	}
+
+	err := schema.Validate("local@localhost")
+	if ruleError, ok := err.(RuleError); !ok || ruleError.Name != RuleEmail {
+		t.Errorf("Validate(%q) = %v, want an email error", "local@localhost", err)
+	}
+}
@@ -0,0 +1,224 @@
+package gosch
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// MaxDecodedSize set the maximum size in bytes of the decoded value used by the encoding rules that are added after it,
+// the input is rejected before it is decoded. The default size is 0, which is unlimited.
+// It will panic if an encoding rule was already added, since the limit would silently not apply to it.
+func (stringSchema StringSchema) MaxDecodedSize(size uint) StringSchema {
+	if stringSchema.encoded {
+		panic("max decoded size must be set before the encoding rules")
+	}
+
+	stringSchema.maxDecodedSize = size
+	return stringSchema
+}
+
+// Base64 validate that a string is encoded with the base64 encoding, e.g. base64.StdEncoding, base64.URLEncoding or base64.RawURLEncoding.
+// It will panic if the encoding is nil.
+// If the input is not valid base64, it will return an error.
+func (stringSchema StringSchema) Base64(encoding *base64.Encoding) StringSchema {
+	if encoding == nil {
+		panic("base64 encoding must not be nil")
+	}
+
+	maxDecodedSize := stringSchema.maxDecodedSize
+	stringSchema.encoded = true
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if err := checkDecodedSize(value, encoding.DecodedLen(len(value)), maxDecodedSize); err != nil {
+			return err
+		}
+
+		if _, err := encoding.DecodeString(value); err != nil {
+			return RuleError{
+				Name:  RuleBase64,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// Base32 validate that a string is encoded with the standard base32 encoding of RFC 4648.
+// If the input is not valid base32, it will return an error.
+func (stringSchema StringSchema) Base32() StringSchema {
+	maxDecodedSize := stringSchema.maxDecodedSize
+	stringSchema.encoded = true
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if err := checkDecodedSize(value, base32.StdEncoding.DecodedLen(len(value)), maxDecodedSize); err != nil {
+			return err
+		}
+
+		if _, err := base32.StdEncoding.DecodeString(value); err != nil {
+			return RuleError{
+				Name:  RuleBase32,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// HexString validate that a string is hex encoded bytes, an even number of hexadecimal digits.
+// If the input is not valid hex encoding, it will return an error.
+func (stringSchema StringSchema) HexString() StringSchema {
+	maxDecodedSize := stringSchema.maxDecodedSize
+	stringSchema.encoded = true
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if err := checkDecodedSize(value, hex.DecodedLen(len(value)), maxDecodedSize); err != nil {
+			return err
+		}
+
+		if _, err := hex.DecodeString(value); err != nil {
+			return RuleError{
+				Name:   RuleHex,
+				Value:  value,
+				Params: []any{uint(0)},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// JSON validate that a string is a valid JSON text.
+// With the optional schema, the text is decoded into an any and the result is validated,
+// so objects become map[string]any, arrays []any and numbers float64.
+// If the input is not valid JSON or its value is not match the schema, it will return an error.
+func (stringSchema StringSchema) JSON(schema ...Schema) StringSchema {
+	maxDecodedSize := stringSchema.maxDecodedSize
+	stringSchema.encoded = true
+	valueSchema := firstOption(schema)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if err := checkDecodedSize(value, len(value), maxDecodedSize); err != nil {
+			return err
+		}
+
+		if valueSchema == nil {
+			if !json.Valid([]byte(value)) {
+				return RuleError{
+					Name:  RuleJSON,
+					Value: value,
+				}
+			}
+			return nil
+		}
+
+		var decoded any
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return RuleError{
+				Name:  RuleJSON,
+				Value: value,
+			}
+		}
+
+		return valueSchema.Validate(decoded)
+	})
+
+	return stringSchema
+}
+
+// JWT validate that a string is a JSON Web Token in the compact serialization, three base64url parts separated by dots.
+// The header must be a JSON object with an "alg" and the claims must be a JSON object, the signature is not verified.
+// With the optional schema, the claims are validated as a map[string]any.
+// If the input is not a well-formed JWT or its claims are not match the schema, it will return an error.
+func (stringSchema StringSchema) JWT(claims ...Schema) StringSchema {
+	maxDecodedSize := stringSchema.maxDecodedSize
+	stringSchema.encoded = true
+	claimsSchema := firstOption(claims)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, decodedClaims, err := parseJWT(value, maxDecodedSize)
+		if err != nil {
+			return err
+		}
+
+		if claimsSchema != nil {
+			return claimsSchema.Validate(decodedClaims)
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// ParseJWT validate the token like the JWT rule and return its decoded header and claims, without verifying the signature.
+// The optional size limit the decoded size like MaxDecodedSize.
+func ParseJWT(value string, maxDecodedSize ...uint) (map[string]any, map[string]any, error) {
+	return parseJWT(value, firstOption(maxDecodedSize))
+}
+
+func parseJWT(value string, maxDecodedSize uint) (map[string]any, map[string]any, error) {
+	ruleError := RuleError{
+		Name:  RuleJWT,
+		Value: value,
+	}
+
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 {
+		return nil, nil, ruleError
+	}
+
+	size := 0
+	for _, part := range parts {
+		size += base64.RawURLEncoding.DecodedLen(len(part))
+	}
+	if err := checkDecodedSize(value, size, maxDecodedSize); err != nil {
+		return nil, nil, err
+	}
+
+	var header, claims map[string]any
+	if !decodeJWTPart(parts[0], &header) || !decodeJWTPart(parts[1], &claims) {
+		return nil, nil, ruleError
+	}
+
+	if algorithm, ok := header["alg"].(string); !ok || algorithm == "" {
+		return nil, nil, ruleError
+	}
+
+	if _, err := base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return nil, nil, ruleError
+	}
+
+	return header, claims, nil
+}
+
+// decodeJWTPart decode a base64url part of a JWT that must be a JSON object.
+func decodeJWTPart(part string, object *map[string]any) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil || !bytes.HasPrefix(bytes.TrimSpace(decoded), []byte("{")) {
+		return false
+	}
+
+	return json.Unmarshal(decoded, object) == nil
+}
+
+// checkDecodedSize return an error if the decoded size is greater than the maximum size.
+func checkDecodedSize(value string, size int, maxDecodedSize uint) error {
+	if maxDecodedSize > 0 && uint(size) > maxDecodedSize {
+		return RuleError{
+			Name:   RuleDecodedSize,
+			Value:  value,
+			Params: []any{maxDecodedSize},
+		}
+	}
+
+	return nil
+}
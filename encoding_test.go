@@ -0,0 +1,61 @@
+package gosch
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestMaxDecodedSize(t *testing.T) {
+	limited := String().MaxDecodedSize(2)
+
+	tests := []struct {
+		name   string
+		schema StringSchema
+		value  string
+		valid  bool
+	}{
+		{name: "hex within", schema: limited.HexString(), value: "abcd", valid: true},
+		{name: "hex over", schema: limited.HexString(), value: "abcdef", valid: false},
+		{name: "base64 within", schema: limited.Base64(base64.RawStdEncoding), value: "aGk", valid: true},
+		{name: "base64 over", schema: limited.Base64(base64.StdEncoding), value: "aGVsbG8=", valid: false},
+		{name: "base32 over", schema: limited.Base32(), value: "NBSWY3DP", valid: false},
+		{name: "JSON within", schema: limited.JSON(), value: "[]", valid: true},
+		{name: "JSON over", schema: limited.JSON(), value: "[1,2]", valid: false},
+		{name: "JWT over", schema: limited.JWT(), value: "eyJhbGciOiJub25lIn0.e30.", valid: false},
+		{name: "unlimited", schema: String().HexString(), value: "abcdef", valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%q) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestMaxDecodedSizePanic(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema func() StringSchema
+	}{
+		{name: "hex", schema: func() StringSchema { return String().HexString() }},
+		{name: "base64", schema: func() StringSchema { return String().Base64(base64.StdEncoding) }},
+		{name: "base32", schema: func() StringSchema { return String().Base32() }},
+		{name: "JSON", schema: func() StringSchema { return String().JSON() }},
+		{name: "JWT", schema: func() StringSchema { return String().JWT() }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("MaxDecodedSize after an encoding rule did not panic")
+				}
+			}()
+
+			test.schema().MaxDecodedSize(1)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package gosch
+
+import (
+	"reflect"
+	"slices"
+)
+
+type EnumSchema[T comparable] struct {
+	nilable bool
+	values  []T
+	match   func(value T, allowed T) bool
+}
+
+// Enum validate that the input is one of the values.
+// If the input is not a T or not equal to any of the values, it will return an error.
+func Enum[T comparable](values ...T) EnumSchema[T] {
+	if len(values) == 0 {
+		panic("enum values must not be empty")
+	}
+
+	return EnumSchema[T]{
+		nilable: false,
+		values:  slices.Clone(values),
+		match:   nil,
+	}
+}
+
+// Nil will pass nil input.
+func (enumSchema EnumSchema[T]) Nil() EnumSchema[T] {
+	enumSchema.nilable = true
+	return enumSchema
+}
+
+// Match set the function that compare the input with an allowed value, the default is ==.
+// For example strings.EqualFold make a string enum case-insensitive.
+func (enumSchema EnumSchema[T]) Match(match func(value T, allowed T) bool) EnumSchema[T] {
+	enumSchema.match = match
+	return enumSchema
+}
+
+// Values return a copy of the allowed values, e.g. to document them or to generate a JSON Schema.
+func (enumSchema EnumSchema[T]) Values() []T {
+	return slices.Clone(enumSchema.values)
+}
+
+func (enumSchema EnumSchema[T]) Validate(value any) error {
+	enumValue, ok, err := typed[T](value)
+	if err != nil || !ok {
+		if !ok && enumSchema.nilable {
+			return nil
+		}
+		return err
+	}
+
+	return enumSchema.validate(enumValue)
+}
+
+func (enumSchema EnumSchema[T]) validate(value T) error {
+	matched := slices.ContainsFunc(enumSchema.values, func(allowed T) bool {
+		if enumSchema.match != nil {
+			return enumSchema.match(value, allowed)
+		}
+		return value == allowed
+	})
+
+	if !matched {
+		return RuleError{
+			Name:   RuleOneOf,
+			Value:  value,
+			Params: []any{enumSchema.values},
+		}
+	}
+
+	return nil
+}
+
+// typed convert the input to T, dereferencing pointers and converting named types with the same kind as T.
+// It return false when the input is nil.
+func typed[T any](value any) (T, bool, error) {
+	var zero T
+
+	targetType := reflect.TypeFor[T]()
+
+	switch typedValue := value.(type) {
+	case T:
+		return typedValue, true, nil
+	case *T:
+		if typedValue != nil {
+			return *typedValue, true, nil
+		}
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	reflectedType := reflect.TypeOf(value)
+
+	if reflectedType == nil || (reflectedValue.Kind() == reflect.Ptr && reflectedValue.IsNil()) {
+		return zero, false, TypeError{
+			Expected: targetType.String(),
+			Actual:   "nil",
+		}
+	}
+
+	if reflectedValue.Kind() == reflect.Ptr {
+		reflectedValue = reflectedValue.Elem()
+		reflectedType = reflectedType.Elem()
+	}
+
+	// Named types with the same underlying kind, e.g. type Status string for Enum[string].
+	if reflectedType.Kind() != targetType.Kind() || !reflectedType.ConvertibleTo(targetType) {
+		return zero, true, TypeError{
+			Expected: targetType.String(),
+			Actual:   reflectedType.Kind().String(),
+		}
+	}
+
+	return reflectedValue.Convert(targetType).Interface().(T), true, nil
+}
+
+// intersectValues return the values of the first OneOf rule that are in every other one, in their original order.
+func intersectValues[T comparable](oneOfs [][]T) []T {
+	if len(oneOfs) == 0 {
+		return nil
+	}
+
+	values := slices.Clone(oneOfs[0])
+	for _, oneOf := range oneOfs[1:] {
+		values = slices.DeleteFunc(values, func(value T) bool {
+			return !slices.Contains(oneOf, value)
+		})
+	}
+
+	return values
+}
@@ -1,6 +1,12 @@
 package gosch
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type TypeError struct {
 	Expected string
@@ -21,6 +27,85 @@ const (
 	RuleMinValue
 	RuleMaxValue
 	RuleField
+	RulePattern
+	RuleNotPattern
+	RuleStartsWith
+	RuleEndsWith
+	RuleIncludes
+	RuleExcludes
+	RuleEmail
+	RuleDate
+	RuleDateTime
+	RuleTime
+	RuleLayout
+	RuleOffset
+	RulePrecision
+	RuleMinTime
+	RuleMaxTime
+	RulePhone
+	RuleNotValue
+	RuleNotLength
+	RuleOneOf
+	RuleLiteral
+	RuleMultipleOf
+	RulePositive
+	RuleNonNegative
+	RuleNegative
+	RuleGreaterThan
+	RuleLessThan
+	RuleBetween
+	RuleFinite
+	RuleNotNaN
+	RuleMaxDecimalPlaces
+	RuleStep
+	RuleBefore
+	RuleAfter
+	RuleNotZero
+	RuleWeekday
+	RuleLocation
+	RuleDuration
+	RuleConvert
+	RuleUUID
+	RuleULID
+	RuleKSUID
+	RuleNanoID
+	RuleHex
+	RuleIP
+	RuleIPv4
+	RuleIPv6
+	RuleCIDR
+	RuleHostname
+	RuleFQDN
+	RuleURL
+	RuleMAC
+	RuleHostPort
+	RuleDeniedAddress
+	RuleCard
+	RuleIBAN
+	RuleISIN
+	RuleBIC
+	RuleCurrency
+	RuleEAN13
+	RuleISBN
+	RuleCountry
+	RuleLanguage
+	RuleLanguageTag
+	RuleTimeZone
+	RuleBase64
+	RuleBase32
+	RuleJSON
+	RuleJWT
+	RuleDecodedSize
+	RuleASCII
+	RulePrintable
+	RuleAlpha
+	RuleAlphanumeric
+	RuleNumeric
+	RuleLowercase
+	RuleUppercase
+	RuleSlug
+	RuleControlChars
+	RuleCharset
 )
 
 type RuleError struct {
@@ -34,22 +119,277 @@ func (ruleError RuleError) Error() string {
 	case RuleNotEmpty:
 		return "value must not be empty"
 	case RuleLength:
-		return fmt.Sprintf("value must be exactly %d in length", ruleError.Params[0])
+		return fmt.Sprintf("value must be exactly %s in length", formatParam(ruleError.Params[0]))
 	case RuleMinLength:
-		return fmt.Sprintf("value must be at least %d in length", ruleError.Params[0])
+		return fmt.Sprintf("value must be at least %s%s in length", formatParam(ruleError.Params[0]), lengthUnit(ruleError.Params))
 	case RuleMaxLength:
-		return fmt.Sprintf("value must be at most %d in length", ruleError.Params[0])
+		return fmt.Sprintf("value must be at most %s%s in length", formatParam(ruleError.Params[0]), lengthUnit(ruleError.Params))
 	case RuleMinValue:
-		return fmt.Sprintf("value must be at least %d", ruleError.Params[0])
+		return fmt.Sprintf("value must be at least %s", formatParam(ruleError.Params[0]))
 	case RuleMaxValue:
-		return fmt.Sprintf("value must be at most %d", ruleError.Params[0])
+		return fmt.Sprintf("value must be at most %s", formatParam(ruleError.Params[0]))
 	case RuleField:
 		return fmt.Sprintf("value must contain field %s", ruleError.Params[0])
+	case RulePattern:
+		if ruleError.Params[1] != "" {
+			return fmt.Sprintf("value must be a valid %s", ruleError.Params[1])
+		}
+		return fmt.Sprintf("value must match pattern %s", ruleError.Params[0])
+	case RuleNotPattern:
+		return fmt.Sprintf("value must not match pattern %s", ruleError.Params[0])
+	case RuleStartsWith:
+		return substringMessage("start with", "one of", ruleError.Params)
+	case RuleEndsWith:
+		return substringMessage("end with", "one of", ruleError.Params)
+	case RuleIncludes:
+		return substringMessage("include", "one of", ruleError.Params)
+	case RuleExcludes:
+		return substringMessage("not include", "any of", ruleError.Params)
+	case RuleEmail:
+		return "value must be a valid email address"
+	case RuleDate:
+		return "value must be a valid date (YYYY-MM-DD)"
+	case RuleDateTime:
+		return "value must be a valid RFC 3339 date-time"
+	case RuleTime:
+		return "value must be a valid RFC 3339 time"
+	case RuleLayout:
+		return fmt.Sprintf("value must be a valid time in layout %q", ruleError.Params[0])
+	case RuleOffset:
+		return "value must have a timezone offset"
+	case RulePrecision:
+		return fmt.Sprintf("value must not be more precise than %s", formatParam(ruleError.Params[0]))
+	case RuleMinTime:
+		return fmt.Sprintf("value must not be before %s", formatParam(ruleError.Params[0]))
+	case RuleMaxTime:
+		return fmt.Sprintf("value must not be after %s", formatParam(ruleError.Params[0]))
+	case RulePhone:
+		return "value must be a valid phone number"
+	case RuleNotValue:
+		return fmt.Sprintf("value must not be %s", valuesMessage(ruleError.Params[0], "any of"))
+	case RuleNotLength:
+		return fmt.Sprintf("value must not be %s%s in length", valuesMessage(ruleError.Params[0], "any of"), lengthUnit(ruleError.Params))
+	case RuleOneOf:
+		message := fmt.Sprintf("value must be %s", valuesMessage(ruleError.Params[0], "one of"))
+		if len(ruleError.Params) > 1 && ruleError.Params[1] == true {
+			message += " (case-insensitive)"
+		}
+		return message
+	case RuleLiteral:
+		return fmt.Sprintf("value must be %s, got %s", formatParam(ruleError.Params[0]), formatParam(ruleError.Value))
+	case RuleMultipleOf:
+		return fmt.Sprintf("value must be a multiple of %s", formatParam(ruleError.Params[0]))
+	case RulePositive:
+		return "value must be positive"
+	case RuleNonNegative:
+		return "value must not be negative"
+	case RuleNegative:
+		return "value must be negative"
+	case RuleGreaterThan:
+		return fmt.Sprintf("value must be greater than %s", formatParam(ruleError.Params[0]))
+	case RuleLessThan:
+		return fmt.Sprintf("value must be less than %s", formatParam(ruleError.Params[0]))
+	case RuleBetween:
+		return fmt.Sprintf("value must be between %s and %s", formatParam(ruleError.Params[0]), formatParam(ruleError.Params[1]))
+	case RuleFinite:
+		return "value must be a finite number"
+	case RuleNotNaN:
+		return "value must be a number"
+	case RuleMaxDecimalPlaces:
+		return fmt.Sprintf("value must have at most %s decimal places", formatParam(ruleError.Params[0]))
+	case RuleStep:
+		return fmt.Sprintf("value must be in steps of %s", formatParam(ruleError.Params[0]))
+	case RuleBefore:
+		return fmt.Sprintf("value must be before %s", formatParam(ruleError.Params[0]))
+	case RuleAfter:
+		return fmt.Sprintf("value must be after %s", formatParam(ruleError.Params[0]))
+	case RuleNotZero:
+		return "value must not be zero"
+	case RuleWeekday:
+		return fmt.Sprintf("value must be on %s", valuesMessage(ruleError.Params[0], "one of"))
+	case RuleLocation:
+		return fmt.Sprintf("value must be in time zone %s", valuesMessage(ruleError.Params[0], "one of"))
+	case RuleDuration:
+		return "value must be a valid duration"
+	case RuleConvert:
+		return fmt.Sprintf("value could not be converted: %s", formatParam(ruleError.Params[0]))
+	case RuleUUID:
+		message := "value must be a UUID"
+		if lowercase, _ := ruleError.Params[1].(bool); lowercase {
+			message = "value must be a lowercase UUID"
+		}
+		if versions := reflect.ValueOf(ruleError.Params[0]); versions.Len() > 0 {
+			message += fmt.Sprintf(" with version %s", valuesMessage(ruleError.Params[0], "one of"))
+		}
+		return message
+	case RuleULID:
+		return "value must be a ULID"
+	case RuleKSUID:
+		return "value must be a KSUID"
+	case RuleNanoID:
+		return fmt.Sprintf("value must be a Nano ID of %s characters", formatParam(ruleError.Params[0]))
+	case RuleHex:
+		if ruleError.Params[0] == uint(0) {
+			return "value must be a hexadecimal string"
+		}
+		return fmt.Sprintf("value must be a hexadecimal string of %s characters", formatParam(ruleError.Params[0]))
+	case RuleIP:
+		return "value must be an IP address"
+	case RuleIPv4:
+		return "value must be an IPv4 address"
+	case RuleIPv6:
+		return "value must be an IPv6 address"
+	case RuleCIDR:
+		return "value must be a CIDR prefix"
+	case RuleHostname:
+		return "value must be a host name"
+	case RuleFQDN:
+		return "value must be a fully qualified domain name"
+	case RuleURL:
+		if schemes := reflect.ValueOf(ruleError.Params[0]); schemes.Len() > 0 {
+			return fmt.Sprintf("value must be a URL with scheme %s", valuesMessage(ruleError.Params[0], "one of"))
+		}
+		return "value must be a URL"
+	case RuleMAC:
+		return "value must be a MAC address"
+	case RuleHostPort:
+		return "value must be a host and port"
+	case RuleDeniedAddress:
+		return fmt.Sprintf("value must not be a %s address", ruleError.Params[0])
+	case RuleCard:
+		if brands := reflect.ValueOf(ruleError.Params[0]); brands.Len() > 0 {
+			return fmt.Sprintf("value must be a card number of brand %s", valuesMessage(ruleError.Params[0], "one of"))
+		}
+		return "value must be a card number"
+	case RuleIBAN:
+		return "value must be an IBAN"
+	case RuleISIN:
+		return "value must be an ISIN"
+	case RuleBIC:
+		return "value must be a BIC"
+	case RuleCurrency:
+		return "value must be an ISO 4217 currency code"
+	case RuleEAN13:
+		return "value must be an EAN-13"
+	case RuleISBN:
+		return "value must be an ISBN"
+	case RuleCountry:
+		if allowed := reflect.ValueOf(ruleError.Params[1]); allowed.Len() > 0 {
+			return fmt.Sprintf("value must be %s", valuesMessage(ruleError.Params[1], "one of"))
+		}
+		return fmt.Sprintf("value must be an ISO 3166-1 %s country code", ruleError.Params[0])
+	case RuleLanguage:
+		if allowed := reflect.ValueOf(ruleError.Params[0]); allowed.Len() > 0 {
+			return fmt.Sprintf("value must be %s", valuesMessage(ruleError.Params[0], "one of"))
+		}
+		return "value must be an ISO 639-1 language code"
+	case RuleLanguageTag:
+		return "value must be a BCP 47 language tag"
+	case RuleTimeZone:
+		if allowed := reflect.ValueOf(ruleError.Params[0]); allowed.Len() > 0 {
+			return fmt.Sprintf("value must be %s", valuesMessage(ruleError.Params[0], "one of"))
+		}
+		return "value must be an IANA time zone"
+	case RuleBase64:
+		return "value must be base64 encoded"
+	case RuleBase32:
+		return "value must be base32 encoded"
+	case RuleJSON:
+		return "value must be valid JSON"
+	case RuleJWT:
+		return "value must be a JSON Web Token"
+	case RuleDecodedSize:
+		return fmt.Sprintf("value must be at most %s bytes when decoded", formatParam(ruleError.Params[0]))
+	case RuleASCII:
+		return charsetMessage("contain only ASCII characters", ruleError.Params)
+	case RulePrintable:
+		return charsetMessage("contain only printable characters", ruleError.Params)
+	case RuleAlpha:
+		return charsetMessage("contain only letters", ruleError.Params)
+	case RuleAlphanumeric:
+		return charsetMessage("contain only letters and digits", ruleError.Params)
+	case RuleNumeric:
+		return charsetMessage("contain only digits", ruleError.Params)
+	case RuleLowercase:
+		return charsetMessage("not contain uppercase letters", ruleError.Params)
+	case RuleUppercase:
+		return charsetMessage("not contain lowercase letters", ruleError.Params)
+	case RuleSlug:
+		return charsetMessage("be a slug", ruleError.Params)
+	case RuleControlChars:
+		return charsetMessage("not contain control characters", ruleError.Params)
+	case RuleCharset:
+		return charsetMessage("contain only characters of the charset", ruleError.Params)
 	default:
 		return "unknown error"
 	}
 }
 
+// formatParam format a rule parameter for an error message.
+// Floats use their shortest representation, times use RFC 3339 and strings are quoted.
+func formatParam(param any) string {
+	switch param := param.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(param), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(param, 'g', -1, 64)
+	case time.Time:
+		return param.Format(time.RFC3339Nano)
+	case string:
+		return strconv.Quote(param)
+	case fmt.Stringer:
+		// time.Duration, *big.Int, *big.Float, *big.Rat and named types.
+		return param.String()
+	default:
+		return fmt.Sprint(param)
+	}
+}
+
+// valuesMessage format a slice of rule values, e.g. "13" or "any of [0 13]".
+func valuesMessage(values any, quantifier string) string {
+	reflectedValues := reflect.ValueOf(values)
+	if reflectedValues.Len() == 1 {
+		return formatParam(reflectedValues.Index(0).Interface())
+	}
+
+	formatted := make([]string, reflectedValues.Len())
+	for i := range formatted {
+		formatted[i] = formatParam(reflectedValues.Index(i).Interface())
+	}
+
+	return fmt.Sprintf("%s [%s]", quantifier, strings.Join(formatted, " "))
+}
+
+// charsetMessage return the message of a character class rule, Params are the offending rune and its position.
+func charsetMessage(requirement string, params []any) string {
+	return fmt.Sprintf("value must %s, found %q at position %d", requirement, params[0], params[1])
+}
+
+// lengthUnit return the unit of a length rule, only string length rules have one.
+func lengthUnit(params []any) string {
+	if len(params) > 1 {
+		return fmt.Sprintf(" %s", params[1])
+	}
+	return ""
+}
+
+func substringMessage(verb string, quantifier string, params []any) string {
+	values := params[0].([]string)
+
+	message := ""
+	if len(values) == 1 {
+		message = fmt.Sprintf("value must %s %q", verb, values[0])
+	} else {
+		message = fmt.Sprintf("value must %s %s %q", verb, quantifier, values)
+	}
+
+	if params[1].(bool) {
+		message += " (case-insensitive)"
+	}
+
+	return message
+}
+
 type FieldError struct {
 	Name  string
 	Value any
@@ -0,0 +1,106 @@
+package gosch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRuleErrorMessage(t *testing.T) {
+	instant := time.Date(2026, 1, 2, 3, 4, 5, 600000000, time.UTC)
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "float32 MinValue", err: Float32().MinValue(0.1).Validate(float32(0.05)), want: "value must be at least 0.1"},
+		{name: "float32 MaxValue", err: Float32().MaxValue(1e-7).Validate(float32(1)), want: "value must be at most 1e-07"},
+		{name: "float64 MinValue", err: Float64().MinValue(0.1).Validate(0.05), want: "value must be at least 0.1"},
+		{name: "float64 MaxValue", err: Float64().MaxValue(1e21).Validate(1e22), want: "value must be at most 1e+21"},
+		{name: "float64 Between", err: Float64().Between(0.5, 1.25).Validate(2.0), want: "value must be between 0.5 and 1.25"},
+		{name: "int MinValue", err: Int().MinValue(-3).Validate(-4), want: "value must be at least -3"},
+		{name: "uint8 MaxValue", err: Uint8().MaxValue(200).Validate(uint8(201)), want: "value must be at most 200"},
+		{name: "int NotValue", err: Int().NotValue(0, 13).Validate(13), want: "value must not be any of [0 13]"},
+		{name: "int OneOf", err: Int().OneOf(1).Validate(2), want: "value must be 1"},
+		{name: "Duration MinValue", err: Duration().MinValue(90 * time.Second).Validate(time.Second), want: "value must be at least 1m30s"},
+		{name: "Duration Truncated", err: Duration().Truncated(time.Millisecond).Validate(time.Microsecond), want: "value must not be more precise than 1ms"},
+		{name: "Time Before", err: Time().Before(instant).Validate(instant), want: "value must be before 2026-01-02T03:04:05.6Z"},
+		{name: "Time Between", err: Time().Between(instant, instant.Add(time.Hour)).Validate(time.Time{}), want: "value must be between 2026-01-02T03:04:05.6Z and 2026-01-02T04:04:05.6Z"},
+		{name: "Time Weekday", err: Time().Weekday(time.Monday, time.Tuesday).Validate(instant), want: "value must be on one of [Monday Tuesday]"},
+		{name: "DateTime MinTime", err: String().DateTime(TimeOptions{Min: At(instant)}).Validate("2000-01-01T00:00:00Z"), want: "value must not be before 2026-01-02T03:04:05.6Z"},
+		{name: "string MinLength bytes", err: String().MinLength(3).Validate("ab"), want: "value must be at least 3 bytes in length"},
+		{name: "string MaxLength graphemes", err: String().Unit(UnitGraphemes).MaxLength(1).Validate("ab"), want: "value must be at most 1 characters in length"},
+		{name: "string NotLength", err: String().NotLength(2).Validate("ab"), want: "value must not be 2 bytes in length"},
+		{name: "slice MinLength", err: Slice().MinLength(2).Validate([]int{1}), want: "value must be at least 2 in length"},
+		{name: "map NotLength", err: Map().NotLength(0, 1).Validate(map[int]int{}), want: "value must not be any of [0 1] in length"},
+		{name: "array Length", err: Array().Length(2).Validate([1]int{}), want: "value must be exactly 2 in length"},
+		{name: "string OneOf", err: String().OneOf("IDR", "USD").Validate("EUR"), want: `value must be one of ["IDR" "USD"]`},
+		{name: "string OneOfFold", err: String().OneOfFold("admin").Validate("root"), want: `value must be "admin" (case-insensitive)`},
+		{name: "string StartsWith", err: String().StartsWith("https://").Validate("ftp://"), want: `value must start with "https://"`},
+		{name: "string EndsWithFold", err: String().EndsWithFold(".png", ".jpg").Validate("a.gif"), want: `value must end with one of [".png" ".jpg"] (case-insensitive)`},
+		{name: "string Excludes", err: String().Excludes("admin", "root").Validate("root"), want: `value must not include any of ["admin" "root"]`},
+		{name: "Enum Stringer", err: Enum(time.Monday).Validate(time.Sunday), want: "value must be Monday"},
+		{name: "Literal string", err: Literal("v1").Validate("v2"), want: `value must be "v1", got "v2"`},
+		{name: "NanoID uint", err: String().NanoID(21).Validate("x"), want: "value must be a Nano ID of 21 characters"},
+		{name: "Card brands", err: String().LuhnCard(CardVisa, CardAmex).Validate("5555555555554444"), want: "value must be a card number of brand one of [Visa American Express]"},
+		{name: "CountryCode format", err: String().CountryCode(CountryAlpha3).Validate("ID"), want: "value must be an ISO 3166-1 alpha-3 country code"},
+		{name: "DecodedSize", err: String().MaxDecodedSize(2).HexString().Validate("abcdef"), want: "value must be at most 2 bytes when decoded"},
+		{name: "Charset", err: String().ASCII().Validate("héllo"), want: "value must contain only ASCII characters, found 'é' at position 1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == nil {
+				t.Fatalf("err = nil, want %q", test.want)
+			}
+			if got := test.err.Error(); got != test.want {
+				t.Errorf("Error() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatParam(t *testing.T) {
+	tests := []struct {
+		param any
+		want  string
+	}{
+		{param: float32(0.1), want: "0.1"},
+		{param: float64(0.1), want: "0.1"},
+		{param: float64(1e21), want: "1e+21"},
+		{param: uint(21), want: "21"},
+		{param: "IDR", want: `"IDR"`},
+		{param: 90 * time.Second, want: "1m30s"},
+		{param: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), want: "2026-01-02T00:00:00Z"},
+		{param: UnitRunes, want: "runes"},
+	}
+
+	for _, test := range tests {
+		if got := formatParam(test.param); got != test.want {
+			t.Errorf("formatParam(%#v) = %q, want %q", test.param, got, test.want)
+		}
+	}
+}
+
+func TestMessageHelpers(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "valuesMessage one", got: valuesMessage([]int{13}, "any of"), want: "13"},
+		{name: "valuesMessage many", got: valuesMessage([]string{"a", "b"}, "one of"), want: `one of ["a" "b"]`},
+		{name: "valuesMessage floats", got: valuesMessage([]float32{0.1, 0.2}, "any of"), want: "any of [0.1 0.2]"},
+		{name: "charsetMessage", got: charsetMessage("be a slug", []any{'-', 0}), want: "value must be a slug, found '-' at position 0"},
+		{name: "lengthUnit without unit", got: lengthUnit([]any{uint(3)}), want: ""},
+		{name: "lengthUnit with unit", got: lengthUnit([]any{uint(3), UnitRunes}), want: " runes"},
+		{name: "substringMessage one", got: substringMessage("include", "one of", []any{[]string{"@"}, false}), want: `value must include "@"`},
+		{name: "substringMessage fold", got: substringMessage("not include", "any of", []any{[]string{"a", "b"}, true}), want: `value must not include any of ["a" "b"] (case-insensitive)`},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s = %q, want %q", test.name, test.got, test.want)
+		}
+	}
+}
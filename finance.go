@@ -0,0 +1,140 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+// ibanLengths map ISO 3166-1 alpha-2 country codes to the length of their IBAN, as published in the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+	"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+	"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+	"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// currencyCodes contain the active ISO 4217 currency codes, including the funds and the precious metal codes.
+var currencyCodes = codeSet(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+	BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUP CVE CZK
+	DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD HNL
+	HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD KZT
+	LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR
+	MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB RWF
+	SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND TOP
+	TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XAG XAU
+	XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW ZWG
+`)
+
+// IBAN validate that a string is an International Bank Account Number with the length of its country and a valid mod-97 check digits.
+// The letters must be uppercase, spaces between the characters are ignored.
+// If the input is not a valid IBAN, it will return an error.
+func (stringSchema StringSchema) IBAN() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		iban := strings.ReplaceAll(value, " ", "")
+
+		if length, ok := ibanLengths[iban[:min(len(iban), 2)]]; !ok || len(iban) != length || !isAlphabet(iban, "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ") || !mod97(iban[4:]+iban[:4]) {
+			return RuleError{
+				Name:  RuleIBAN,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// ISIN validate that a string is an International Securities Identification Number, e.g. "US0378331005".
+// If the input is not a valid ISIN, it will return an error.
+func (stringSchema StringSchema) ISIN() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if len(value) != 12 || !isAlphabet(value[:2], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") || !isAlphabet(value[2:11], "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ") || !isAlphabet(value[11:], "0123456789") || !luhn(alphanumericDigits(value)) {
+			return RuleError{
+				Name:  RuleISIN,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// BIC validate that a string is an ISO 9362 Business Identifier Code (SWIFT code) of 8 or 11 uppercase characters, e.g. "DEUTDEFF500".
+// If the input is not a valid BIC, it will return an error.
+func (stringSchema StringSchema) BIC() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if (len(value) != 8 && len(value) != 11) || !isAlphabet(value[:6], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") || !isAlphabet(value[6:], "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+			return RuleError{
+				Name:  RuleBIC,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// ISO4217Currency validate that a string is an active ISO 4217 alphabetic currency code, e.g. "IDR" or "USD".
+// If the input is not a known currency code, it will return an error.
+func (stringSchema StringSchema) ISO4217Currency() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !currencyCodes[value] {
+			return RuleError{
+				Name:  RuleCurrency,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// alphanumericDigits replace every letter of an uppercase alphanumeric string with its two digit value, A is 10 and Z is 35.
+func alphanumericDigits(value string) string {
+	var builder strings.Builder
+
+	for i := range len(value) {
+		if c := value[i]; c >= 'A' && c <= 'Z' {
+			builder.WriteByte('0' + (c-'A'+10)/10)
+			builder.WriteByte('0' + (c-'A'+10)%10)
+		} else {
+			builder.WriteByte(c)
+		}
+	}
+
+	return builder.String()
+}
+
+// mod97 validate an uppercase alphanumeric string with the ISO 7064 MOD 97-10 check.
+func mod97(value string) bool {
+	remainder := 0
+	for i := range len(value) {
+		if c := value[i]; c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+
+	return remainder == 1
+}
+
+// codeSet split a whitespace separated list of codes into a set.
+func codeSet(codes string) map[string]bool {
+	set := map[string]bool{}
+	for code := range strings.FieldsSeq(codes) {
+		set[code] = true
+	}
+	return set
+}
@@ -0,0 +1,55 @@
+package gosch
+
+import "testing"
+
+func TestFinanceCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema StringSchema
+		value  string
+		valid  bool
+	}{
+		{name: "IBAN", schema: String().IBAN(), value: "GB82WEST12345698765432", valid: true},
+		{name: "IBAN with spaces", schema: String().IBAN(), value: "GB82 WEST 1234 5698 7654 32", valid: true},
+		{name: "IBAN Germany", schema: String().IBAN(), value: "DE89370400440532013000", valid: true},
+		{name: "IBAN Norway", schema: String().IBAN(), value: "NO9386011117947", valid: true},
+		{name: "IBAN wrong check digits", schema: String().IBAN(), value: "GB83WEST12345698765432", valid: false},
+		{name: "IBAN wrong account", schema: String().IBAN(), value: "GB82WEST12345698765433", valid: false},
+		{name: "IBAN wrong length", schema: String().IBAN(), value: "GB82WEST1234569876543", valid: false},
+		{name: "IBAN lowercase", schema: String().IBAN(), value: "gb82west12345698765432", valid: false},
+		{name: "IBAN unknown country", schema: String().IBAN(), value: "ZZ82WEST12345698765432", valid: false},
+		{name: "ISIN", schema: String().ISIN(), value: "US0378331005", valid: true},
+		{name: "ISIN with letters", schema: String().ISIN(), value: "AU0000XVGZA3", valid: true},
+		{name: "ISIN Great Britain", schema: String().ISIN(), value: "GB0002634946", valid: true},
+		{name: "ISIN wrong check digit", schema: String().ISIN(), value: "US0378331006", valid: false},
+		{name: "ISIN swapped digits", schema: String().ISIN(), value: "US0373831005", valid: false},
+		{name: "ISIN lowercase", schema: String().ISIN(), value: "us0378331005", valid: false},
+		{name: "ISIN too short", schema: String().ISIN(), value: "US037833100", valid: false},
+		{name: "BIC 8", schema: String().BIC(), value: "DEUTDEFF", valid: true},
+		{name: "BIC 11", schema: String().BIC(), value: "DEUTDEFF500", valid: true},
+		{name: "BIC lowercase", schema: String().BIC(), value: "deutdeff", valid: false},
+		{name: "BIC wrong length", schema: String().BIC(), value: "DEUTDEF", valid: false},
+		{name: "currency", schema: String().ISO4217Currency(), value: "IDR", valid: true},
+		{name: "currency unknown", schema: String().ISO4217Currency(), value: "ABC", valid: false},
+		{name: "currency lowercase", schema: String().ISO4217Currency(), value: "usd", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%q) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestMod97(t *testing.T) {
+	// The IBAN check moves the country code and check digits to the end.
+	if !mod97("WEST12345698765432GB82") {
+		t.Error("mod97(GB82WEST12345698765432 rearranged) = false, want true")
+	}
+	if mod97("WEST12345698765432GB83") {
+		t.Error("mod97(GB83WEST12345698765432 rearranged) = true, want false")
+	}
+}
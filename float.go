@@ -0,0 +1,63 @@
+package gosch
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The machine epsilons of float32 and float64, used by the MultipleOf and Step rules when the schema has no tolerance.
+const (
+	float32Epsilon = 0x1p-23
+	float64Epsilon = 0x1p-52
+)
+
+// isMultiple check that value is an integer multiple of step, allowing an absolute error of tolerance.
+func isMultiple(value float64, step float64, tolerance float64) bool {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return false
+	}
+
+	if tolerance == 0 {
+		return math.Mod(value, step) == 0
+	}
+
+	quotient := value / step
+	return math.Abs(quotient-math.Round(quotient))*math.Abs(step) <= tolerance
+}
+
+// isStep check that value is an integer multiple of step like isMultiple,
+// but without tolerance it allow a few units in the last place of the quotient, since decimal steps such as 0.1 are not exact in binary.
+// The allowance is never more than a hundredth of a step, so an input between two steps is rejected at any magnitude.
+func isStep(value float64, step float64, tolerance float64, epsilon float64) bool {
+	if tolerance > 0 {
+		return isMultiple(value, step, tolerance)
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return false
+	}
+
+	quotient := value / step
+	allowance := min(4*epsilon*math.Abs(quotient), 0.01)
+
+	return math.Abs(quotient-math.Round(quotient)) <= allowance
+}
+
+// hasMaxDecimalPlaces check that value has at most places decimal places.
+// Without tolerance the shortest decimal representation of the value is used.
+func hasMaxDecimalPlaces(value float64, places uint, tolerance float64, bitSize int) bool {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return false
+	}
+
+	if tolerance > 0 {
+		return isMultiple(value, math.Pow10(-int(places)), tolerance)
+	}
+
+	formatted := strconv.FormatFloat(value, 'f', -1, bitSize)
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 {
+		return len(formatted)-dot-1 <= int(places)
+	}
+	return true
+}
@@ -1,20 +1,31 @@
 package gosch
 
-import "reflect"
+import (
+	"math"
+	"reflect"
+	"slices"
+)
 
 type Float32Rule func(value float32) error
 
 type Float32Schema struct {
-	nilable bool
-	rules   []Float32Rule
+	nilable   bool
+	typ       reflect.Type
+	tolerance float64
+	oneOfs    [][]float32
+	rules     []Float32Rule
 }
 
 // Float32 validate data type of the input.
 // If the input is not an float, it will return an error.
+// NaN never pass the value and sign rules.
 func Float32() Float32Schema {
 	return Float32Schema{
-		nilable: false,
-		rules:   []Float32Rule{},
+		nilable:   false,
+		typ:       nil,
+		tolerance: 0,
+		oneOfs:    [][]float32{},
+		rules:     []Float32Rule{},
 	}
 }
 
@@ -24,11 +35,23 @@ func (float32Schema Float32Schema) Nil() Float32Schema {
 	return float32Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the float32 kind.
+// It will panic if the kind of the type is not float32.
+// If the input is of another type, it will return an error.
+func (float32Schema Float32Schema) Type(exact reflect.Type) Float32Schema {
+	if exact == nil || exact.Kind() != reflect.Float32 {
+		panic("float32 type must be of kind float32")
+	}
+
+	float32Schema.typ = exact
+	return float32Schema
+}
+
 // MinValue validate the minimum value of an float.
 // If the input is less than the minimum value, it will return an error.
 func (float32Schema Float32Schema) MinValue(min float32) Float32Schema {
-	float32Schema.rules = append(float32Schema.rules, func(value float32) error {
-		if value < min {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value < min {
 			return RuleError{
 				Name:   RuleMinValue,
 				Value:  value,
@@ -44,8 +67,8 @@ func (float32Schema Float32Schema) MinValue(min float32) Float32Schema {
 // MaxValue validate the maximum value of an float.
 // If the input is greater than the maximum value, it will return an error.
 func (float32Schema Float32Schema) MaxValue(max float32) Float32Schema {
-	float32Schema.rules = append(float32Schema.rules, func(value float32) error {
-		if value > max {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
 				Value:  value,
@@ -58,7 +81,292 @@ func (float32Schema Float32Schema) MaxValue(max float32) Float32Schema {
 	return float32Schema
 }
 
+// MultipleOf validate that an float is a multiple of n, within the schema tolerance.
+// Without tolerance it accept the representation error of decimal steps, so MultipleOf(0.1) pass 0.3.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (float32Schema Float32Schema) MultipleOf(n float32) Float32Schema {
+	if n == 0 {
+		panic("float32 multiple must not be 0")
+	}
+
+	tolerance := float32Schema.tolerance
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if !isStep(float64(value), float64(n), tolerance, float32Epsilon) {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Positive validate that an float is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (float32Schema Float32Schema) Positive() Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// NonNegative validate that an float is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (float32Schema Float32Schema) NonNegative() Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Negative validate that an float is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (float32Schema Float32Schema) Negative() Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an float.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (float32Schema Float32Schema) GreaterThan(min float32) Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// LessThan validate the exclusive maximum value of an float.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (float32Schema Float32Schema) LessThan(max float32) Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Between validate that an float is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (float32Schema Float32Schema) Between(min float32, max float32) Float32Schema {
+	if min > max {
+		panic("float32 minimum value must be less than or equal to the maximum value")
+	}
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Tolerance set the absolute tolerance of the MultipleOf, Step and MaxDecimalPlaces rules that are added after it.
+// It will panic if the tolerance is negative.
+func (float32Schema Float32Schema) Tolerance(tolerance float64) Float32Schema {
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		panic("float32 tolerance must be greater than or equal to 0")
+	}
+
+	float32Schema.tolerance = tolerance
+	return float32Schema
+}
+
+// Finite validate that an float is neither NaN nor an infinity.
+// If the input is NaN or an infinity, it will return an error.
+func (float32Schema Float32Schema) Finite() Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return RuleError{
+				Name:  RuleFinite,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// NotNaN validate that an float is not NaN.
+// If the input is NaN, it will return an error.
+func (float32Schema Float32Schema) NotNaN() Float32Schema {
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if math.IsNaN(float64(value)) {
+			return RuleError{
+				Name:  RuleNotNaN,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// MaxDecimalPlaces validate the maximum number of decimal places of an float, e.g. 2 for most currencies.
+// Without tolerance the shortest decimal representation of the input is counted.
+// If the input has more decimal places, it will return an error.
+func (float32Schema Float32Schema) MaxDecimalPlaces(places uint) Float32Schema {
+	tolerance := float32Schema.tolerance
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if !hasMaxDecimalPlaces(float64(value), places, tolerance, 32) {
+			return RuleError{
+				Name:   RuleMaxDecimalPlaces,
+				Value:  value,
+				Params: []any{places},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Step validate that an float is a whole number of steps from 0, e.g. Step(0.01) for cents.
+// Without tolerance it accept the representation error of decimal steps, same as MultipleOf,
+// but still reject an input between two steps at any magnitude, e.g. a half-cent.
+// It will panic if the step is not greater than 0.
+// If the input is not on a step, it will return an error.
+func (float32Schema Float32Schema) Step(step float32) Float32Schema {
+	if !(step > 0) {
+		panic("float32 step must be greater than 0")
+	}
+
+	tolerance := float32Schema.tolerance
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if !isStep(float64(value), float64(step), tolerance, float32Epsilon) {
+			return RuleError{
+				Name:   RuleStep,
+				Value:  value,
+				Params: []any{step},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// OneOf validate that an float is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (float32Schema Float32Schema) OneOf(values ...float32) Float32Schema {
+	if len(values) == 0 {
+		panic("float32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	float32Schema.oneOfs = append(slices.Clip(float32Schema.oneOfs), values)
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (float32Schema Float32Schema) Values() []float32 {
+	return intersectValues(float32Schema.oneOfs)
+}
+
+// NotValue validate that an float is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (float32Schema Float32Schema) NotValue(values ...float32) Float32Schema {
+	if len(values) == 0 {
+		panic("float32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	float32Schema.rules = append(slices.Clip(float32Schema.rules), func(value float32) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return float32Schema
+}
+
 func (float32Schema Float32Schema) Validate(value any) error {
+	// The unnamed float32 and *float32 are the most common inputs, so they skip the reflection.
+	if float32Schema.typ == nil {
+		switch float32Value := value.(type) {
+		case float32:
+			return float32Schema.validate(float32Value)
+		case *float32:
+			if float32Value != nil {
+				return float32Schema.validate(*float32Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +393,19 @@ func (float32Schema Float32Schema) Validate(value any) error {
 		}
 	}
 
-	float32Value := float32(reflectedValue.Float())
+	if float32Schema.typ != nil && reflectedType != float32Schema.typ {
+		return TypeError{
+			Expected: float32Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return float32Schema.validate(float32(reflectedValue.Float()))
+}
 
+func (float32Schema Float32Schema) validate(value float32) error {
 	for _, rule := range float32Schema.rules {
-		if err := rule(float32Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
@@ -1,20 +1,31 @@
 package gosch
 
-import "reflect"
+import (
+	"math"
+	"reflect"
+	"slices"
+)
 
 type Float64Rule func(value float64) error
 
 type Float64Schema struct {
-	nilable bool
-	rules   []Float64Rule
+	nilable   bool
+	typ       reflect.Type
+	tolerance float64
+	oneOfs    [][]float64
+	rules     []Float64Rule
 }
 
 // Float64 validate data type of the input.
 // If the input is not an float, it will return an error.
+// NaN never pass the value and sign rules.
 func Float64() Float64Schema {
 	return Float64Schema{
-		nilable: false,
-		rules:   []Float64Rule{},
+		nilable:   false,
+		typ:       nil,
+		tolerance: 0,
+		oneOfs:    [][]float64{},
+		rules:     []Float64Rule{},
 	}
 }
 
@@ -24,11 +35,23 @@ func (float64Schema Float64Schema) Nil() Float64Schema {
 	return float64Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the float64 kind.
+// It will panic if the kind of the type is not float64.
+// If the input is of another type, it will return an error.
+func (float64Schema Float64Schema) Type(exact reflect.Type) Float64Schema {
+	if exact == nil || exact.Kind() != reflect.Float64 {
+		panic("float64 type must be of kind float64")
+	}
+
+	float64Schema.typ = exact
+	return float64Schema
+}
+
 // MinValue validate the minimum value of an float.
 // If the input is less than the minimum value, it will return an error.
 func (float64Schema Float64Schema) MinValue(min float64) Float64Schema {
-	float64Schema.rules = append(float64Schema.rules, func(value float64) error {
-		if value < min {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value < min {
 			return RuleError{
 				Name:   RuleMinValue,
 				Value:  value,
@@ -44,8 +67,8 @@ func (float64Schema Float64Schema) MinValue(min float64) Float64Schema {
 // MaxValue validate the maximum value of an float.
 // If the input is greater than the maximum value, it will return an error.
 func (float64Schema Float64Schema) MaxValue(max float64) Float64Schema {
-	float64Schema.rules = append(float64Schema.rules, func(value float64) error {
-		if value > max {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
 				Value:  value,
@@ -58,7 +81,292 @@ func (float64Schema Float64Schema) MaxValue(max float64) Float64Schema {
 	return float64Schema
 }
 
+// MultipleOf validate that an float is a multiple of n, within the schema tolerance.
+// Without tolerance it accept the representation error of decimal steps, so MultipleOf(0.1) pass 0.3.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (float64Schema Float64Schema) MultipleOf(n float64) Float64Schema {
+	if n == 0 {
+		panic("float64 multiple must not be 0")
+	}
+
+	tolerance := float64Schema.tolerance
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if !isStep(value, float64(n), tolerance, float64Epsilon) {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Positive validate that an float is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (float64Schema Float64Schema) Positive() Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// NonNegative validate that an float is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (float64Schema Float64Schema) NonNegative() Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Negative validate that an float is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (float64Schema Float64Schema) Negative() Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an float.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (float64Schema Float64Schema) GreaterThan(min float64) Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// LessThan validate the exclusive maximum value of an float.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (float64Schema Float64Schema) LessThan(max float64) Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Between validate that an float is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (float64Schema Float64Schema) Between(min float64, max float64) Float64Schema {
+	if min > max {
+		panic("float64 minimum value must be less than or equal to the maximum value")
+	}
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Tolerance set the absolute tolerance of the MultipleOf, Step and MaxDecimalPlaces rules that are added after it.
+// It will panic if the tolerance is negative.
+func (float64Schema Float64Schema) Tolerance(tolerance float64) Float64Schema {
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		panic("float64 tolerance must be greater than or equal to 0")
+	}
+
+	float64Schema.tolerance = tolerance
+	return float64Schema
+}
+
+// Finite validate that an float is neither NaN nor an infinity.
+// If the input is NaN or an infinity, it will return an error.
+func (float64Schema Float64Schema) Finite() Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return RuleError{
+				Name:  RuleFinite,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// NotNaN validate that an float is not NaN.
+// If the input is NaN, it will return an error.
+func (float64Schema Float64Schema) NotNaN() Float64Schema {
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if math.IsNaN(value) {
+			return RuleError{
+				Name:  RuleNotNaN,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// MaxDecimalPlaces validate the maximum number of decimal places of an float, e.g. 2 for most currencies.
+// Without tolerance the shortest decimal representation of the input is counted.
+// If the input has more decimal places, it will return an error.
+func (float64Schema Float64Schema) MaxDecimalPlaces(places uint) Float64Schema {
+	tolerance := float64Schema.tolerance
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if !hasMaxDecimalPlaces(value, places, tolerance, 64) {
+			return RuleError{
+				Name:   RuleMaxDecimalPlaces,
+				Value:  value,
+				Params: []any{places},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Step validate that an float is a whole number of steps from 0, e.g. Step(0.01) for cents.
+// Without tolerance it accept the representation error of decimal steps, same as MultipleOf,
+// but still reject an input between two steps at any magnitude, e.g. a half-cent.
+// It will panic if the step is not greater than 0.
+// If the input is not on a step, it will return an error.
+func (float64Schema Float64Schema) Step(step float64) Float64Schema {
+	if !(step > 0) {
+		panic("float64 step must be greater than 0")
+	}
+
+	tolerance := float64Schema.tolerance
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if !isStep(value, float64(step), tolerance, float64Epsilon) {
+			return RuleError{
+				Name:   RuleStep,
+				Value:  value,
+				Params: []any{step},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// OneOf validate that an float is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (float64Schema Float64Schema) OneOf(values ...float64) Float64Schema {
+	if len(values) == 0 {
+		panic("float64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	float64Schema.oneOfs = append(slices.Clip(float64Schema.oneOfs), values)
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (float64Schema Float64Schema) Values() []float64 {
+	return intersectValues(float64Schema.oneOfs)
+}
+
+// NotValue validate that an float is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (float64Schema Float64Schema) NotValue(values ...float64) Float64Schema {
+	if len(values) == 0 {
+		panic("float64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	float64Schema.rules = append(slices.Clip(float64Schema.rules), func(value float64) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return float64Schema
+}
+
 func (float64Schema Float64Schema) Validate(value any) error {
+	// The unnamed float64 and *float64 are the most common inputs, so they skip the reflection.
+	if float64Schema.typ == nil {
+		switch float64Value := value.(type) {
+		case float64:
+			return float64Schema.validate(float64Value)
+		case *float64:
+			if float64Value != nil {
+				return float64Schema.validate(*float64Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +393,19 @@ func (float64Schema Float64Schema) Validate(value any) error {
 		}
 	}
 
-	float64Value := float64(reflectedValue.Float())
+	if float64Schema.typ != nil && reflectedType != float64Schema.typ {
+		return TypeError{
+			Expected: float64Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return float64Schema.validate(float64(reflectedValue.Float()))
+}
 
+func (float64Schema Float64Schema) validate(value float64) error {
 	for _, rule := range float64Schema.rules {
-		if err := rule(float64Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
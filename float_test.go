@@ -0,0 +1,54 @@
+package gosch
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatMultipleOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		valid  bool
+	}{
+		{name: "float64 decimal multiple", schema: Float64().MultipleOf(0.1), value: 0.3, valid: true},
+		{name: "float64 negative decimal multiple", schema: Float64().MultipleOf(0.1), value: -0.7, valid: true},
+		{name: "float64 large decimal multiple", schema: Float64().MultipleOf(0.01), value: 123456.78, valid: true},
+		{name: "float64 negative step", schema: Float64().MultipleOf(-0.1), value: 0.3, valid: true},
+		{name: "float64 not a multiple", schema: Float64().MultipleOf(0.1), value: 0.35, valid: false},
+		{name: "float64 exact multiple", schema: Float64().MultipleOf(0.5), value: 2.5, valid: true},
+		{name: "float64 NaN", schema: Float64().MultipleOf(0.1), value: math.NaN(), valid: false},
+		{name: "float64 infinity", schema: Float64().MultipleOf(0.1), value: math.Inf(1), valid: false},
+		{name: "float64 explicit tolerance", schema: Float64().Tolerance(0.01).MultipleOf(0.1), value: 0.305, valid: true},
+		{name: "float64 large odd", schema: Float64().MultipleOf(2), value: 1e9 + 1, valid: false},
+		{name: "float64 large half", schema: Float64().MultipleOf(1), value: 1e9 + 0.5, valid: false},
+		{name: "float64 large negative half", schema: Float64().MultipleOf(1), value: -1e12 - 0.5, valid: false},
+		{name: "float64 large negative decimal", schema: Float64().MultipleOf(0.1), value: -12345678.9, valid: true},
+		{name: "float64 large negative off", schema: Float64().MultipleOf(0.1), value: -12345678.95, valid: false},
+		{name: "float64 large whole", schema: Float64().MultipleOf(3), value: 3e15, valid: true},
+		{name: "float64 step", schema: Float64().Step(0.1), value: 0.3, valid: true},
+		{name: "float64 step off", schema: Float64().Step(0.01), value: 0.015, valid: false},
+		{name: "float64 large step", schema: Float64().Step(0.01), value: 12345678.01, valid: true},
+		{name: "float64 large half step", schema: Float64().Step(0.01), value: 12345678.005, valid: false},
+		{name: "float64 large negative half step", schema: Float64().Step(0.01), value: -98765432.105, valid: false},
+		{name: "float64 large negative step", schema: Float64().Step(0.01), value: -98765432.1, valid: true},
+		{name: "float32 decimal multiple", schema: Float32().MultipleOf(0.1), value: float32(0.3), valid: true},
+		{name: "float32 not a multiple", schema: Float32().MultipleOf(0.1), value: float32(0.35), valid: false},
+		{name: "float32 large half", schema: Float32().MultipleOf(1), value: float32(600000.5), valid: false},
+		{name: "float32 large negative half", schema: Float32().MultipleOf(1), value: float32(-600000.5), valid: false},
+		{name: "float32 large negative decimal", schema: Float32().MultipleOf(0.5), value: float32(-123456.5), valid: true},
+		{name: "float32 step", schema: Float32().Step(0.05), value: float32(1.15), valid: true},
+		{name: "float32 large half step", schema: Float32().Step(1), value: float32(-300000.5), valid: false},
+		{name: "float32 large step", schema: Float32().Step(0.25), value: float32(-300000.25), valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
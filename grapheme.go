@@ -0,0 +1,310 @@
+package gosch
+
+import "unicode"
+
+// graphemeProperty is the Grapheme_Cluster_Break property of a rune as defined by UAX #29.
+type graphemeProperty uint8
+
+const (
+	graphemeOther graphemeProperty = iota
+	graphemeCR
+	graphemeLF
+	graphemeControl
+	graphemeExtend
+	graphemeZWJ
+	graphemeRegionalIndicator
+	graphemePrepend
+	graphemeSpacingMark
+	graphemeL
+	graphemeV
+	graphemeT
+	graphemeLV
+	graphemeLVT
+)
+
+// graphemeExtendRunes contain the Extend runes that are not part of unicode.Mn, unicode.Me or unicode.Other_Grapheme_Extend.
+var graphemeExtendRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x200c, Hi: 0x200c, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+	},
+}
+
+// graphemePrependRunes contain the Prepend runes that are not part of unicode.Prepended_Concatenation_Mark.
+var graphemePrependRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x111c2, Hi: 0x111c3, Stride: 1},
+		{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+		{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+		{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+		{Lo: 0x11a84, Hi: 0x11a89, Stride: 1},
+		{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+	},
+}
+
+// graphemeSpacingMarkRunes contain the SpacingMark runes that are not part of unicode.Mc.
+var graphemeSpacingMarkRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0e33, Hi: 0x0e33, Stride: 1},
+		{Lo: 0x0eb3, Hi: 0x0eb3, Stride: 1},
+	},
+}
+
+// graphemePictographicRunes contain the runes with the Extended_Pictographic property from the Unicode emoji data.
+var graphemePictographicRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2605, Stride: 1},
+		{Lo: 0x2607, Hi: 0x2612, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2685, Stride: 1},
+		{Lo: 0x2690, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2767, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+		{Lo: 0x1f12f, Hi: 0x1f12f, Stride: 1},
+		{Lo: 0x1f16c, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f546, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+		{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// graphemeLinkerRunes contain the viramas with the Indic_Conjunct_Break property Linker.
+var graphemeLinkerRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x094d, Hi: 0x094d, Stride: 1},
+		{Lo: 0x09cd, Hi: 0x09cd, Stride: 1},
+		{Lo: 0x0acd, Hi: 0x0acd, Stride: 1},
+		{Lo: 0x0b4d, Hi: 0x0b4d, Stride: 1},
+		{Lo: 0x0c4d, Hi: 0x0c4d, Stride: 1},
+		{Lo: 0x0d4d, Hi: 0x0d4d, Stride: 1},
+	},
+}
+
+// graphemeConsonantRunes contain the runes with the Indic_Conjunct_Break property Consonant.
+var graphemeConsonantRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0915, Hi: 0x0939, Stride: 1},
+		{Lo: 0x0958, Hi: 0x095f, Stride: 1},
+		{Lo: 0x0978, Hi: 0x097f, Stride: 1},
+		{Lo: 0x0995, Hi: 0x09a8, Stride: 1},
+		{Lo: 0x09aa, Hi: 0x09b0, Stride: 1},
+		{Lo: 0x09b2, Hi: 0x09b2, Stride: 1},
+		{Lo: 0x09b6, Hi: 0x09b9, Stride: 1},
+		{Lo: 0x09dc, Hi: 0x09dd, Stride: 1},
+		{Lo: 0x09df, Hi: 0x09df, Stride: 1},
+		{Lo: 0x09f0, Hi: 0x09f1, Stride: 1},
+		{Lo: 0x0a95, Hi: 0x0aa8, Stride: 1},
+		{Lo: 0x0aaa, Hi: 0x0ab0, Stride: 1},
+		{Lo: 0x0ab2, Hi: 0x0ab3, Stride: 1},
+		{Lo: 0x0ab5, Hi: 0x0ab9, Stride: 1},
+		{Lo: 0x0af9, Hi: 0x0af9, Stride: 1},
+		{Lo: 0x0b15, Hi: 0x0b28, Stride: 1},
+		{Lo: 0x0b2a, Hi: 0x0b30, Stride: 1},
+		{Lo: 0x0b32, Hi: 0x0b33, Stride: 1},
+		{Lo: 0x0b35, Hi: 0x0b39, Stride: 1},
+		{Lo: 0x0b5c, Hi: 0x0b5d, Stride: 1},
+		{Lo: 0x0b5f, Hi: 0x0b5f, Stride: 1},
+		{Lo: 0x0b71, Hi: 0x0b71, Stride: 1},
+		{Lo: 0x0c15, Hi: 0x0c28, Stride: 1},
+		{Lo: 0x0c2a, Hi: 0x0c39, Stride: 1},
+		{Lo: 0x0c58, Hi: 0x0c5a, Stride: 1},
+		{Lo: 0x0d15, Hi: 0x0d3a, Stride: 1},
+	},
+}
+
+func graphemePropertyOf(r rune) graphemeProperty {
+	switch {
+	case r == '\r':
+		return graphemeCR
+	case r == '\n':
+		return graphemeLF
+	case r == 0x200d:
+		return graphemeZWJ
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return graphemeRegionalIndicator
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return graphemeL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return graphemeV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return graphemeT
+	case r >= 0xac00 && r <= 0xd7a3:
+		// Every 28th precomposed syllable has no trailing consonant.
+		if (r-0xac00)%28 == 0 {
+			return graphemeLV
+		}
+		return graphemeLVT
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend, graphemeExtendRunes):
+		return graphemeExtend
+	case unicode.In(r, unicode.Prepended_Concatenation_Mark, graphemePrependRunes):
+		return graphemePrepend
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return graphemeControl
+	case unicode.In(r, unicode.Mc, graphemeSpacingMarkRunes):
+		return graphemeSpacingMark
+	default:
+		return graphemeOther
+	}
+}
+
+// graphemeCount return the number of extended grapheme clusters (user-perceived characters) in a string,
+// following the rules of UAX #29 for Unicode 15.1.
+func graphemeCount(value string) int {
+	count := 0
+
+	previous := graphemeOther
+	regionalIndicators := 0
+	pictographic := false // The current cluster is an Extended_Pictographic followed by Extend*.
+	pictographicZWJ := false
+	consonant := false // The current cluster end with an Indic consonant followed by Extend* and Linker*.
+	consonantLinker := false
+
+	for i, r := range value {
+		current := graphemePropertyOf(r)
+		isPictographic := unicode.Is(graphemePictographicRunes, r)
+		isConsonant := unicode.Is(graphemeConsonantRunes, r)
+		isLinker := unicode.Is(graphemeLinkerRunes, r)
+
+		if i == 0 || graphemeBreak(previous, current, regionalIndicators, pictographicZWJ && isPictographic, consonantLinker && isConsonant) {
+			count++
+			regionalIndicators = 0
+			pictographic = false
+		}
+
+		if current == graphemeRegionalIndicator {
+			regionalIndicators++
+		}
+
+		switch {
+		case isPictographic:
+			pictographic = true
+			pictographicZWJ = false
+		case pictographic && current == graphemeExtend:
+		case pictographic && current == graphemeZWJ:
+			pictographicZWJ = true
+		default:
+			pictographic = false
+			pictographicZWJ = false
+		}
+
+		switch {
+		case isConsonant:
+			consonant = true
+			consonantLinker = false
+		case consonant && isLinker:
+			consonantLinker = true
+		case consonant && (current == graphemeExtend || current == graphemeZWJ):
+		default:
+			consonant = false
+			consonantLinker = false
+		}
+
+		previous = current
+	}
+
+	return count
+}
+
+// graphemeBreak apply the grapheme cluster boundary rules GB3 to GB999 between two runes.
+// The emojiSequence (GB11) and indicConjunct (GB9c) rules need the previous runes of the cluster, so they are tracked by the caller.
+func graphemeBreak(previous graphemeProperty, current graphemeProperty, regionalIndicators int, emojiSequence bool, indicConjunct bool) bool {
+	switch {
+	case previous == graphemeCR && current == graphemeLF:
+		return false
+	case previous == graphemeCR, previous == graphemeLF, previous == graphemeControl:
+		return true
+	case current == graphemeCR, current == graphemeLF, current == graphemeControl:
+		return true
+	case previous == graphemeL && (current == graphemeL || current == graphemeV || current == graphemeLV || current == graphemeLVT):
+		return false
+	case (previous == graphemeLV || previous == graphemeV) && (current == graphemeV || current == graphemeT):
+		return false
+	case (previous == graphemeLVT || previous == graphemeT) && current == graphemeT:
+		return false
+	case current == graphemeExtend, current == graphemeZWJ, current == graphemeSpacingMark:
+		return false
+	case previous == graphemePrepend:
+		return false
+	case indicConjunct:
+		return false
+	case emojiSequence && previous == graphemeZWJ:
+		return false
+	case previous == graphemeRegionalIndicator && current == graphemeRegionalIndicator:
+		return regionalIndicators%2 == 0
+	default:
+		return true
+	}
+}
@@ -0,0 +1,42 @@
+package gosch
+
+import "testing"
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "ascii", value: "abc", want: 3},
+		{name: "CRLF", value: "\r\n", want: 1},
+		{name: "LFCR", value: "\n\r", want: 2},
+		{name: "CRLF between letters", value: "a\r\nb", want: 3},
+		{name: "combining mark", value: "e\u0301", want: 1},
+		{name: "ZWJ family", value: "👨‍👩‍👧", want: 1},
+		{name: "ZWJ with skin tone", value: "👩🏽‍💻", want: 1},
+		{name: "skin tone", value: "👍🏽", want: 1},
+		{name: "ZWJ after letter", value: "a\u200d👍", want: 2},
+		{name: "two flags", value: "🇮🇩🇯🇵", want: 2},
+		{name: "odd regional indicators", value: "🇮🇩🇯", want: 2},
+		{name: "Hangul syllables", value: "한국어", want: 3},
+		{name: "Hangul jamo", value: "\u1100\u1161\u11a8", want: 1},
+		{name: "Devanagari conjunct", value: "नमस्ते", want: 3},
+		{name: "Devanagari conjunct with vowel sign", value: "क्षि", want: 1},
+		{name: "Devanagari conjunct with ZWJ", value: "क्\u200dष", want: 1},
+		{name: "Devanagari virama before vowel", value: "क्अ", want: 2},
+		{name: "Bengali conjunct", value: "ক্ষ", want: 1},
+		{name: "Malayalam conjunct", value: "ക്ക", want: 1},
+		{name: "Tamil virama", value: "க்ஷ", want: 2},
+		{name: "Thai", value: "กำ", want: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := graphemeCount(test.value); got != test.want {
+				t.Errorf("graphemeCount(%q) = %d, want %d", test.value, got, test.want)
+			}
+		})
+	}
+}
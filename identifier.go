@@ -0,0 +1,103 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// crockfordAlphabet is the Crockford's Base32 alphabet of ULIDs.
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// base62Alphabet is the alphabet of KSUIDs, in ASCII order.
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// nanoIDAlphabet is the default URL-safe alphabet of Nano IDs.
+	nanoIDAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+	// ksuidMax is the largest 160-bit KSUID.
+	ksuidMax = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+// ULID validate that a string is a ULID, 26 characters of Crockford's Base32, e.g. "01ARZ3NDEKTSV4RRFFQ69G5FAV".
+// Lowercase letters are accepted.
+// If the input is not a valid ULID, it will return an error.
+func (stringSchema StringSchema) ULID() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		// The first character must be at most "7", otherwise the 128-bit value overflow.
+		if len(value) != 26 || value[0] > '7' || !isAlphabet(strings.ToUpper(value), crockfordAlphabet) {
+			return RuleError{
+				Name:  RuleULID,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// KSUID validate that a string is a KSUID, 27 characters of base62, e.g. "0ujtsYcgvSTl8PAuAdqWYSMnLOv".
+// If the input is not a valid KSUID, it will return an error.
+func (stringSchema StringSchema) KSUID() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		// The alphabet is in ASCII order, so KSUIDs of the same length compare like strings.
+		if len(value) != 27 || value > ksuidMax || !isAlphabet(value, base62Alphabet) {
+			return RuleError{
+				Name:  RuleKSUID,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// NanoID validate that a string is a Nano ID of the default URL-safe alphabet with the length, the default length of Nano IDs is 21.
+// It will panic if the length is 0.
+// If the input is not a valid Nano ID, it will return an error.
+func (stringSchema StringSchema) NanoID(length uint) StringSchema {
+	if length == 0 {
+		panic("nanoid length must be greater than 0")
+	}
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if uint(len(value)) != length || !isAlphabet(value, nanoIDAlphabet) {
+			return RuleError{
+				Name:   RuleNanoID,
+				Value:  value,
+				Params: []any{length},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// Hex validate that a string is hexadecimal digits with the length, e.g. Hex(64) for a SHA-256 digest.
+// A length of 0 accept any non-empty length.
+// If the input is not valid hexadecimal digits, it will return an error.
+func (stringSchema StringSchema) Hex(length uint) StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if value == "" || (length != 0 && uint(len(value)) != length) || !isAlphabet(value, "0123456789abcdefABCDEF") {
+			return RuleError{
+				Name:   RuleHex,
+				Value:  value,
+				Params: []any{length},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// isAlphabet report whether every character of a string is in the alphabet.
+func isAlphabet(value string, alphabet string) bool {
+	for _, r := range value {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
+		}
+	}
+
+	return true
+}
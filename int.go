@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type IntRule func(value int) error
 
 type IntSchema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]int
 	rules   []IntRule
 }
 
@@ -14,6 +19,8 @@ type IntSchema struct {
 func Int() IntSchema {
 	return IntSchema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]int{},
 		rules:   []IntRule{},
 	}
 }
@@ -24,10 +31,22 @@ func (intSchema IntSchema) Nil() IntSchema {
 	return intSchema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the int kind.
+// It will panic if the kind of the type is not int.
+// If the input is of another type, it will return an error.
+func (intSchema IntSchema) Type(exact reflect.Type) IntSchema {
+	if exact == nil || exact.Kind() != reflect.Int {
+		panic("int type must be of kind int")
+	}
+
+	intSchema.typ = exact
+	return intSchema
+}
+
 // MinValue validate the minimum value of an int.
 // If the input is less than the minimum value, it will return an error.
 func (intSchema IntSchema) MinValue(min int) IntSchema {
-	intSchema.rules = append(intSchema.rules, func(value int) error {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (intSchema IntSchema) MinValue(min int) IntSchema {
 // MaxValue validate the maximum value of an int.
 // If the input is greater than the maximum value, it will return an error.
 func (intSchema IntSchema) MaxValue(max int) IntSchema {
-	intSchema.rules = append(intSchema.rules, func(value int) error {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,200 @@ func (intSchema IntSchema) MaxValue(max int) IntSchema {
 	return intSchema
 }
 
+// MultipleOf validate that an int is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (intSchema IntSchema) MultipleOf(n int) IntSchema {
+	if n == 0 {
+		panic("int multiple must not be 0")
+	}
+
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// Positive validate that an int is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (intSchema IntSchema) Positive() IntSchema {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// NonNegative validate that an int is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (intSchema IntSchema) NonNegative() IntSchema {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// Negative validate that an int is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (intSchema IntSchema) Negative() IntSchema {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// GreaterThan validate the exclusive minimum value of an int.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (intSchema IntSchema) GreaterThan(min int) IntSchema {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// LessThan validate the exclusive maximum value of an int.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (intSchema IntSchema) LessThan(max int) IntSchema {
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// Between validate that an int is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (intSchema IntSchema) Between(min int, max int) IntSchema {
+	if min > max {
+		panic("int minimum value must be less than or equal to the maximum value")
+	}
+
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// OneOf validate that an int is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (intSchema IntSchema) OneOf(values ...int) IntSchema {
+	if len(values) == 0 {
+		panic("int values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	intSchema.oneOfs = append(slices.Clip(intSchema.oneOfs), values)
+
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (intSchema IntSchema) Values() []int {
+	return intersectValues(intSchema.oneOfs)
+}
+
+// NotValue validate that an int is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (intSchema IntSchema) NotValue(values ...int) IntSchema {
+	if len(values) == 0 {
+		panic("int values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	intSchema.rules = append(slices.Clip(intSchema.rules), func(value int) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return intSchema
+}
+
 func (intSchema IntSchema) Validate(value any) error {
+	// The unnamed int and *int are the most common inputs, so they skip the reflection.
+	if intSchema.typ == nil {
+		switch intValue := value.(type) {
+		case int:
+			return intSchema.validate(intValue)
+		case *int:
+			if intValue != nil {
+				return intSchema.validate(*intValue)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +297,19 @@ func (intSchema IntSchema) Validate(value any) error {
 		}
 	}
 
-	intValue := int(reflectedValue.Int())
+	if intSchema.typ != nil && reflectedType != intSchema.typ {
+		return TypeError{
+			Expected: intSchema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return intSchema.validate(int(reflectedValue.Int()))
+}
 
+func (intSchema IntSchema) validate(value int) error {
 	for _, rule := range intSchema.rules {
-		if err := rule(intValue); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
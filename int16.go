@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Int16Rule func(value int16) error
 
 type Int16Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]int16
 	rules   []Int16Rule
 }
 
@@ -14,6 +19,8 @@ type Int16Schema struct {
 func Int16() Int16Schema {
 	return Int16Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]int16{},
 		rules:   []Int16Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (int16Schema Int16Schema) Nil() Int16Schema {
 	return int16Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the int16 kind.
+// It will panic if the kind of the type is not int16.
+// If the input is of another type, it will return an error.
+func (int16Schema Int16Schema) Type(exact reflect.Type) Int16Schema {
+	if exact == nil || exact.Kind() != reflect.Int16 {
+		panic("int16 type must be of kind int16")
+	}
+
+	int16Schema.typ = exact
+	return int16Schema
+}
+
 // MinValue validate the minimum value of an int16.
 // If the input is less than the minimum value, it will return an error.
 func (int16Schema Int16Schema) MinValue(min int16) Int16Schema {
-	int16Schema.rules = append(int16Schema.rules, func(value int16) error {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (int16Schema Int16Schema) MinValue(min int16) Int16Schema {
 // MaxValue validate the maximum value of an int16.
 // If the input is greater than the maximum value, it will return an error.
 func (int16Schema Int16Schema) MaxValue(max int16) Int16Schema {
-	int16Schema.rules = append(int16Schema.rules, func(value int16) error {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,200 @@ func (int16Schema Int16Schema) MaxValue(max int16) Int16Schema {
 	return int16Schema
 }
 
+// MultipleOf validate that an int16 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (int16Schema Int16Schema) MultipleOf(n int16) Int16Schema {
+	if n == 0 {
+		panic("int16 multiple must not be 0")
+	}
+
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// Positive validate that an int16 is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (int16Schema Int16Schema) Positive() Int16Schema {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// NonNegative validate that an int16 is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (int16Schema Int16Schema) NonNegative() Int16Schema {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// Negative validate that an int16 is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (int16Schema Int16Schema) Negative() Int16Schema {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an int16.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (int16Schema Int16Schema) GreaterThan(min int16) Int16Schema {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// LessThan validate the exclusive maximum value of an int16.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (int16Schema Int16Schema) LessThan(max int16) Int16Schema {
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// Between validate that an int16 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (int16Schema Int16Schema) Between(min int16, max int16) Int16Schema {
+	if min > max {
+		panic("int16 minimum value must be less than or equal to the maximum value")
+	}
+
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// OneOf validate that an int16 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (int16Schema Int16Schema) OneOf(values ...int16) Int16Schema {
+	if len(values) == 0 {
+		panic("int16 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int16Schema.oneOfs = append(slices.Clip(int16Schema.oneOfs), values)
+
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (int16Schema Int16Schema) Values() []int16 {
+	return intersectValues(int16Schema.oneOfs)
+}
+
+// NotValue validate that an int16 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (int16Schema Int16Schema) NotValue(values ...int16) Int16Schema {
+	if len(values) == 0 {
+		panic("int16 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int16Schema.rules = append(slices.Clip(int16Schema.rules), func(value int16) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int16Schema
+}
+
 func (int16Schema Int16Schema) Validate(value any) error {
+	// The unnamed int16 and *int16 are the most common inputs, so they skip the reflection.
+	if int16Schema.typ == nil {
+		switch int16Value := value.(type) {
+		case int16:
+			return int16Schema.validate(int16Value)
+		case *int16:
+			if int16Value != nil {
+				return int16Schema.validate(*int16Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +297,19 @@ func (int16Schema Int16Schema) Validate(value any) error {
 		}
 	}
 
-	int16Value := int16(reflectedValue.Int())
+	if int16Schema.typ != nil && reflectedType != int16Schema.typ {
+		return TypeError{
+			Expected: int16Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return int16Schema.validate(int16(reflectedValue.Int()))
+}
 
+func (int16Schema Int16Schema) validate(value int16) error {
 	for _, rule := range int16Schema.rules {
-		if err := rule(int16Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Int32Rule func(value int32) error
 
 type Int32Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]int32
 	rules   []Int32Rule
 }
 
@@ -14,6 +19,8 @@ type Int32Schema struct {
 func Int32() Int32Schema {
 	return Int32Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]int32{},
 		rules:   []Int32Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (int32Schema Int32Schema) Nil() Int32Schema {
 	return int32Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the int32 kind.
+// It will panic if the kind of the type is not int32.
+// If the input is of another type, it will return an error.
+func (int32Schema Int32Schema) Type(exact reflect.Type) Int32Schema {
+	if exact == nil || exact.Kind() != reflect.Int32 {
+		panic("int32 type must be of kind int32")
+	}
+
+	int32Schema.typ = exact
+	return int32Schema
+}
+
 // MinValue validate the minimum value of an int32.
 // If the input is less than the minimum value, it will return an error.
 func (int32Schema Int32Schema) MinValue(min int32) Int32Schema {
-	int32Schema.rules = append(int32Schema.rules, func(value int32) error {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (int32Schema Int32Schema) MinValue(min int32) Int32Schema {
 // MaxValue validate the maximum value of an int32.
 // If the input is greater than the maximum value, it will return an error.
 func (int32Schema Int32Schema) MaxValue(max int32) Int32Schema {
-	int32Schema.rules = append(int32Schema.rules, func(value int32) error {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,200 @@ func (int32Schema Int32Schema) MaxValue(max int32) Int32Schema {
 	return int32Schema
 }
 
+// MultipleOf validate that an int32 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (int32Schema Int32Schema) MultipleOf(n int32) Int32Schema {
+	if n == 0 {
+		panic("int32 multiple must not be 0")
+	}
+
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// Positive validate that an int32 is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (int32Schema Int32Schema) Positive() Int32Schema {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// NonNegative validate that an int32 is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (int32Schema Int32Schema) NonNegative() Int32Schema {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// Negative validate that an int32 is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (int32Schema Int32Schema) Negative() Int32Schema {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an int32.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (int32Schema Int32Schema) GreaterThan(min int32) Int32Schema {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// LessThan validate the exclusive maximum value of an int32.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (int32Schema Int32Schema) LessThan(max int32) Int32Schema {
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// Between validate that an int32 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (int32Schema Int32Schema) Between(min int32, max int32) Int32Schema {
+	if min > max {
+		panic("int32 minimum value must be less than or equal to the maximum value")
+	}
+
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// OneOf validate that an int32 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (int32Schema Int32Schema) OneOf(values ...int32) Int32Schema {
+	if len(values) == 0 {
+		panic("int32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int32Schema.oneOfs = append(slices.Clip(int32Schema.oneOfs), values)
+
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (int32Schema Int32Schema) Values() []int32 {
+	return intersectValues(int32Schema.oneOfs)
+}
+
+// NotValue validate that an int32 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (int32Schema Int32Schema) NotValue(values ...int32) Int32Schema {
+	if len(values) == 0 {
+		panic("int32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int32Schema.rules = append(slices.Clip(int32Schema.rules), func(value int32) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int32Schema
+}
+
 func (int32Schema Int32Schema) Validate(value any) error {
+	// The unnamed int32 and *int32 are the most common inputs, so they skip the reflection.
+	if int32Schema.typ == nil {
+		switch int32Value := value.(type) {
+		case int32:
+			return int32Schema.validate(int32Value)
+		case *int32:
+			if int32Value != nil {
+				return int32Schema.validate(*int32Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +297,19 @@ func (int32Schema Int32Schema) Validate(value any) error {
 		}
 	}
 
-	int32Value := int32(reflectedValue.Int())
+	if int32Schema.typ != nil && reflectedType != int32Schema.typ {
+		return TypeError{
+			Expected: int32Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return int32Schema.validate(int32(reflectedValue.Int()))
+}
 
+func (int32Schema Int32Schema) validate(value int32) error {
 	for _, rule := range int32Schema.rules {
-		if err := rule(int32Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
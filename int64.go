@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Int64Rule func(value int64) error
 
 type Int64Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]int64
 	rules   []Int64Rule
 }
 
@@ -14,6 +19,8 @@ type Int64Schema struct {
 func Int64() Int64Schema {
 	return Int64Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]int64{},
 		rules:   []Int64Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (int64Schema Int64Schema) Nil() Int64Schema {
 	return int64Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the int64 kind.
+// It will panic if the kind of the type is not int64.
+// If the input is of another type, it will return an error.
+func (int64Schema Int64Schema) Type(exact reflect.Type) Int64Schema {
+	if exact == nil || exact.Kind() != reflect.Int64 {
+		panic("int64 type must be of kind int64")
+	}
+
+	int64Schema.typ = exact
+	return int64Schema
+}
+
 // MinValue validate the minimum value of an int64.
 // If the input is less than the minimum value, it will return an error.
 func (int64Schema Int64Schema) MinValue(min int64) Int64Schema {
-	int64Schema.rules = append(int64Schema.rules, func(value int64) error {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (int64Schema Int64Schema) MinValue(min int64) Int64Schema {
 // MaxValue validate the maximum value of an int64.
 // If the input is greater than the maximum value, it will return an error.
 func (int64Schema Int64Schema) MaxValue(max int64) Int64Schema {
-	int64Schema.rules = append(int64Schema.rules, func(value int64) error {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,200 @@ func (int64Schema Int64Schema) MaxValue(max int64) Int64Schema {
 	return int64Schema
 }
 
+// MultipleOf validate that an int64 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (int64Schema Int64Schema) MultipleOf(n int64) Int64Schema {
+	if n == 0 {
+		panic("int64 multiple must not be 0")
+	}
+
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// Positive validate that an int64 is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (int64Schema Int64Schema) Positive() Int64Schema {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// NonNegative validate that an int64 is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (int64Schema Int64Schema) NonNegative() Int64Schema {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// Negative validate that an int64 is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (int64Schema Int64Schema) Negative() Int64Schema {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an int64.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (int64Schema Int64Schema) GreaterThan(min int64) Int64Schema {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// LessThan validate the exclusive maximum value of an int64.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (int64Schema Int64Schema) LessThan(max int64) Int64Schema {
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// Between validate that an int64 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (int64Schema Int64Schema) Between(min int64, max int64) Int64Schema {
+	if min > max {
+		panic("int64 minimum value must be less than or equal to the maximum value")
+	}
+
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// OneOf validate that an int64 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (int64Schema Int64Schema) OneOf(values ...int64) Int64Schema {
+	if len(values) == 0 {
+		panic("int64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int64Schema.oneOfs = append(slices.Clip(int64Schema.oneOfs), values)
+
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (int64Schema Int64Schema) Values() []int64 {
+	return intersectValues(int64Schema.oneOfs)
+}
+
+// NotValue validate that an int64 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (int64Schema Int64Schema) NotValue(values ...int64) Int64Schema {
+	if len(values) == 0 {
+		panic("int64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int64Schema.rules = append(slices.Clip(int64Schema.rules), func(value int64) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int64Schema
+}
+
 func (int64Schema Int64Schema) Validate(value any) error {
+	// The unnamed int64 and *int64 are the most common inputs, so they skip the reflection.
+	if int64Schema.typ == nil {
+		switch int64Value := value.(type) {
+		case int64:
+			return int64Schema.validate(int64Value)
+		case *int64:
+			if int64Value != nil {
+				return int64Schema.validate(*int64Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +297,19 @@ func (int64Schema Int64Schema) Validate(value any) error {
 		}
 	}
 
-	int64Value := int64(reflectedValue.Int())
+	if int64Schema.typ != nil && reflectedType != int64Schema.typ {
+		return TypeError{
+			Expected: int64Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return int64Schema.validate(int64(reflectedValue.Int()))
+}
 
+func (int64Schema Int64Schema) validate(value int64) error {
 	for _, rule := range int64Schema.rules {
-		if err := rule(int64Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
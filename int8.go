@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Int8Rule func(value int8) error
 
 type Int8Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]int8
 	rules   []Int8Rule
 }
 
@@ -14,6 +19,8 @@ type Int8Schema struct {
 func Int8() Int8Schema {
 	return Int8Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]int8{},
 		rules:   []Int8Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (int8Schema Int8Schema) Nil() Int8Schema {
 	return int8Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the int8 kind.
+// It will panic if the kind of the type is not int8.
+// If the input is of another type, it will return an error.
+func (int8Schema Int8Schema) Type(exact reflect.Type) Int8Schema {
+	if exact == nil || exact.Kind() != reflect.Int8 {
+		panic("int8 type must be of kind int8")
+	}
+
+	int8Schema.typ = exact
+	return int8Schema
+}
+
 // MinValue validate the minimum value of an int8.
 // If the input is less than the minimum value, it will return an error.
 func (int8Schema Int8Schema) MinValue(min int8) Int8Schema {
-	int8Schema.rules = append(int8Schema.rules, func(value int8) error {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (int8Schema Int8Schema) MinValue(min int8) Int8Schema {
 // MaxValue validate the maximum value of an int8.
 // If the input is greater than the maximum value, it will return an error.
 func (int8Schema Int8Schema) MaxValue(max int8) Int8Schema {
-	int8Schema.rules = append(int8Schema.rules, func(value int8) error {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,200 @@ func (int8Schema Int8Schema) MaxValue(max int8) Int8Schema {
 	return int8Schema
 }
 
+// MultipleOf validate that an int8 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (int8Schema Int8Schema) MultipleOf(n int8) Int8Schema {
+	if n == 0 {
+		panic("int8 multiple must not be 0")
+	}
+
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// Positive validate that an int8 is greater than 0.
+// If the input is less than or equal to 0, it will return an error.
+func (int8Schema Int8Schema) Positive() Int8Schema {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value <= 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// NonNegative validate that an int8 is greater than or equal to 0.
+// If the input is less than 0, it will return an error.
+func (int8Schema Int8Schema) NonNegative() Int8Schema {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value < 0 {
+			return RuleError{
+				Name:  RuleNonNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// Negative validate that an int8 is less than 0.
+// If the input is greater than or equal to 0, it will return an error.
+func (int8Schema Int8Schema) Negative() Int8Schema {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value >= 0 {
+			return RuleError{
+				Name:  RuleNegative,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an int8.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (int8Schema Int8Schema) GreaterThan(min int8) Int8Schema {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// LessThan validate the exclusive maximum value of an int8.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (int8Schema Int8Schema) LessThan(max int8) Int8Schema {
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// Between validate that an int8 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (int8Schema Int8Schema) Between(min int8, max int8) Int8Schema {
+	if min > max {
+		panic("int8 minimum value must be less than or equal to the maximum value")
+	}
+
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// OneOf validate that an int8 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (int8Schema Int8Schema) OneOf(values ...int8) Int8Schema {
+	if len(values) == 0 {
+		panic("int8 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int8Schema.oneOfs = append(slices.Clip(int8Schema.oneOfs), values)
+
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (int8Schema Int8Schema) Values() []int8 {
+	return intersectValues(int8Schema.oneOfs)
+}
+
+// NotValue validate that an int8 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (int8Schema Int8Schema) NotValue(values ...int8) Int8Schema {
+	if len(values) == 0 {
+		panic("int8 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	int8Schema.rules = append(slices.Clip(int8Schema.rules), func(value int8) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return int8Schema
+}
+
 func (int8Schema Int8Schema) Validate(value any) error {
+	// The unnamed int8 and *int8 are the most common inputs, so they skip the reflection.
+	if int8Schema.typ == nil {
+		switch int8Value := value.(type) {
+		case int8:
+			return int8Schema.validate(int8Value)
+		case *int8:
+			if int8Value != nil {
+				return int8Schema.validate(*int8Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +297,19 @@ func (int8Schema Int8Schema) Validate(value any) error {
 		}
 	}
 
-	int8Value := int8(reflectedValue.Int())
+	if int8Schema.typ != nil && reflectedType != int8Schema.typ {
+		return TypeError{
+			Expected: int8Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return int8Schema.validate(int8(reflectedValue.Int()))
+}
 
+func (int8Schema Int8Schema) validate(value int8) error {
 	for _, rule := range int8Schema.rules {
-		if err := rule(int8Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,134 @@
+package gosch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNumericValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values any
+		want   any
+	}{
+		{name: "int", values: Int().OneOf(200, 201, 204).Values(), want: []int{200, 201, 204}},
+		{name: "int without OneOf", values: Int().Positive().Values(), want: []int(nil)},
+		{name: "int intersection", values: Int().OneOf(1, 2, 3).OneOf(3, 2).Values(), want: []int{2, 3}},
+		{name: "int8", values: Int8().OneOf(-1, 1).Values(), want: []int8{-1, 1}},
+		{name: "int16", values: Int16().OneOf(1).Values(), want: []int16{1}},
+		{name: "int32", values: Int32().OneOf(1).Values(), want: []int32{1}},
+		{name: "int64", values: Int64().OneOf(1).Values(), want: []int64{1}},
+		{name: "uint", values: Uint().OneOf(1).Values(), want: []uint{1}},
+		{name: "uint8", values: Uint8().OneOf(1).Values(), want: []uint8{1}},
+		{name: "uint16", values: Uint16().OneOf(1).Values(), want: []uint16{1}},
+		{name: "uint32", values: Uint32().OneOf(1).Values(), want: []uint32{1}},
+		{name: "uint64", values: Uint64().OneOf(1, 2).OneOf(4).Values(), want: []uint64{}},
+		{name: "float32", values: Float32().OneOf(0.5, 1.5).Values(), want: []float32{0.5, 1.5}},
+		{name: "float64", values: Float64().OneOf(0.5, 1.5).Values(), want: []float64{0.5, 1.5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !reflect.DeepEqual(test.values, test.want) {
+				t.Errorf("Values() = %#v, want %#v", test.values, test.want)
+			}
+		})
+	}
+}
+
+func TestNumericValuesCopy(t *testing.T) {
+	schema := Int().OneOf(1, 2)
+
+	values := schema.Values()
+	values[0] = 3
+
+	if err := schema.Validate(1); err != nil {
+		t.Errorf("Validate(1) = %v, want nil", err)
+	}
+	if err := schema.Validate(3); err == nil {
+		t.Error("Validate(3) = nil, want an error")
+	}
+}
+
+type (
+	testInt     int
+	testInt8    int8
+	testInt16   int16
+	testInt32   int32
+	testInt64   int64
+	testUint    uint
+	testUint8   uint8
+	testUint16  uint16
+	testUint32  uint32
+	testUint64  uint64
+	testFloat32 float32
+	testFloat64 float64
+	testOther   int64
+)
+
+func TestNumericType(t *testing.T) {
+	id := testInt64(7)
+
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		valid  bool
+	}{
+		{name: "int exact", schema: Int().Type(reflect.TypeFor[testInt]()), value: testInt(1), valid: true},
+		{name: "int plain", schema: Int().Type(reflect.TypeFor[testInt]()), value: 1, valid: false},
+		{name: "int without Type", schema: Int(), value: testInt(1), valid: true},
+		{name: "int8 exact", schema: Int8().Type(reflect.TypeFor[testInt8]()), value: testInt8(1), valid: true},
+		{name: "int8 plain", schema: Int8().Type(reflect.TypeFor[testInt8]()), value: int8(1), valid: false},
+		{name: "int16 exact", schema: Int16().Type(reflect.TypeFor[testInt16]()), value: testInt16(1), valid: true},
+		{name: "int16 plain", schema: Int16().Type(reflect.TypeFor[testInt16]()), value: int16(1), valid: false},
+		{name: "int32 exact", schema: Int32().Type(reflect.TypeFor[testInt32]()), value: testInt32(1), valid: true},
+		{name: "int32 plain", schema: Int32().Type(reflect.TypeFor[testInt32]()), value: int32(1), valid: false},
+		{name: "int64 exact", schema: Int64().Type(reflect.TypeFor[testInt64]()), value: testInt64(1), valid: true},
+		{name: "int64 pointer", schema: Int64().Type(reflect.TypeFor[testInt64]()), value: &id, valid: true},
+		{name: "int64 other named", schema: Int64().Type(reflect.TypeFor[testInt64]()), value: testOther(1), valid: false},
+		{name: "int64 plain", schema: Int64().Type(reflect.TypeFor[testInt64]()), value: int64(1), valid: false},
+		{name: "uint exact", schema: Uint().Type(reflect.TypeFor[testUint]()), value: testUint(1), valid: true},
+		{name: "uint plain", schema: Uint().Type(reflect.TypeFor[testUint]()), value: uint(1), valid: false},
+		{name: "uint8 exact", schema: Uint8().Type(reflect.TypeFor[testUint8]()), value: testUint8(1), valid: true},
+		{name: "uint8 plain", schema: Uint8().Type(reflect.TypeFor[testUint8]()), value: uint8(1), valid: false},
+		{name: "uint16 exact", schema: Uint16().Type(reflect.TypeFor[testUint16]()), value: testUint16(1), valid: true},
+		{name: "uint16 plain", schema: Uint16().Type(reflect.TypeFor[testUint16]()), value: uint16(1), valid: false},
+		{name: "uint32 exact", schema: Uint32().Type(reflect.TypeFor[testUint32]()), value: testUint32(1), valid: true},
+		{name: "uint32 plain", schema: Uint32().Type(reflect.TypeFor[testUint32]()), value: uint32(1), valid: false},
+		{name: "uint64 exact", schema: Uint64().Type(reflect.TypeFor[testUint64]()), value: testUint64(1), valid: true},
+		{name: "uint64 plain", schema: Uint64().Type(reflect.TypeFor[testUint64]()), value: uint64(1), valid: false},
+		{name: "float32 exact", schema: Float32().Type(reflect.TypeFor[testFloat32]()), value: testFloat32(1), valid: true},
+		{name: "float32 plain", schema: Float32().Type(reflect.TypeFor[testFloat32]()), value: float32(1), valid: false},
+		{name: "float64 exact", schema: Float64().Type(reflect.TypeFor[testFloat64]()), value: testFloat64(1), valid: true},
+		{name: "float64 plain", schema: Float64().Type(reflect.TypeFor[testFloat64]()), value: 1.0, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestNumericTypeError(t *testing.T) {
+	err := Int64().Type(reflect.TypeFor[testInt64]()).Validate(testOther(1))
+
+	typeError, ok := err.(TypeError)
+	if !ok || typeError.Expected != "gosch.testInt64" || typeError.Actual != "gosch.testOther" {
+		t.Errorf("Validate(testOther(1)) = %v, want a gosch.testInt64 type error", err)
+	}
+}
+
+func TestNumericTypePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int().Type(reflect.TypeFor[string]()) did not panic")
+		}
+	}()
+
+	Int().Type(reflect.TypeFor[string]())
+}
@@ -0,0 +1,157 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+// languageCodes contain the ISO 639-1 two letter language codes.
+var languageCodes = codeSet(`
+	aa ab ae af ak am an ar as av ay az ba be bg bh bi bm bn bo br bs ca ce ch co cr
+	cs cu cv cy da de dv dz ee el en eo es et eu fa ff fi fj fo fr fy ga gd gl gn gu
+	gv ha he hi ho hr ht hu hy hz ia id ie ig ii ik io is it iu ja jv ka kg ki kj kk
+	kl km kn ko kr ks ku kv kw ky la lb lg li ln lo lt lu lv mg mh mi mk ml mn mr ms
+	mt my na nb nd ne ng nl nn no nr nv ny oc oj om or os pa pi pl ps pt qu rm rn ro
+	ru rw sa sc sd se sg si sk sl sm sn so sq sr ss st su sv sw ta te tg th ti tk tl
+	tn to tr ts tt tw ty ug uk ur uz ve vi vo wa wo xh yi yo za zh zu
+`)
+
+// grandfatheredTags contain the irregular and regular grandfathered tags of BCP 47, which do not follow the langtag syntax.
+var grandfatheredTags = codeSet(`
+	en-gb-oed i-ami i-bnn i-default i-enochian i-hak i-klingon i-lux i-mingo i-navajo i-pwn i-tao i-tay
+	i-tsu sgn-be-fr sgn-be-nl sgn-ch-de art-lojban cel-gaulish no-bok no-nyn zh-guoyu zh-hakka zh-min
+	zh-min-nan zh-xiang
+`)
+
+// LanguageCode validate that a string is a lowercase ISO 639-1 language code, e.g. "id" or "en".
+// The code must be one of the allowed codes, or any code if there are none.
+// It will panic if an allowed code is unknown.
+// If the input is not a valid language code, it will return an error.
+func (stringSchema StringSchema) LanguageCode(allowed ...string) StringSchema {
+	allowed = slices.Clone(allowed)
+	codes := allowedCodes(languageCodes, allowed, "language code")
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !codes[value] {
+			return RuleError{
+				Name:   RuleLanguage,
+				Value:  value,
+				Params: []any{allowed},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// LanguageTag validate that a string is a well-formed BCP 47 language tag, e.g. "en", "id-ID" or "zh-Hant-TW".
+// Only the syntax is checked, the subtags are not looked up in the IANA registry.
+// If the input is not a well-formed language tag, it will return an error.
+func (stringSchema StringSchema) LanguageTag() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !isLanguageTag(value) {
+			return RuleError{
+				Name:  RuleLanguageTag,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// isLanguageTag validate the syntax of RFC 5646:
+// language ["-" script] ["-" region] *("-" variant) *("-" extension) ["-" privateuse].
+func isLanguageTag(value string) bool {
+	// strings.ToLower map some non-ASCII letters to ASCII, e.g. the Kelvin sign to "k", so they are rejected first.
+	if !isAlphabet(value, "-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+		return false
+	}
+
+	tag := strings.ToLower(value)
+	if grandfatheredTags[tag] {
+		return true
+	}
+
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if subtag == "" || len(subtag) > 8 || !isAlphabet(subtag, "0123456789abcdefghijklmnopqrstuvwxyz") {
+			return false
+		}
+	}
+
+	if subtags[0] == "x" {
+		return isPrivateUse(subtags)
+	}
+
+	i := 0
+	// The primary language subtag of 2 to 8 letters, with up to three extended language subtags after a 2 or 3 letter one.
+	if len(subtags[i]) < 2 || !isLetters(subtags[i]) {
+		return false
+	}
+	if len(subtags[i]) <= 3 {
+		for extlangs := 0; extlangs < 3 && i+1 < len(subtags) && len(subtags[i+1]) == 3 && isLetters(subtags[i+1]); extlangs++ {
+			i++
+		}
+	}
+	i++
+
+	// The script subtag.
+	if i < len(subtags) && len(subtags[i]) == 4 && isLetters(subtags[i]) {
+		i++
+	}
+
+	// The region subtag.
+	if i < len(subtags) && (len(subtags[i]) == 2 && isLetters(subtags[i]) || len(subtags[i]) == 3 && isDigits(subtags[i])) {
+		i++
+	}
+
+	// The variant subtags, which must not repeat.
+	variants := map[string]bool{}
+	for i < len(subtags) && (len(subtags[i]) >= 5 || len(subtags[i]) == 4 && isDigits(subtags[i][:1])) {
+		if variants[subtags[i]] {
+			return false
+		}
+		variants[subtags[i]] = true
+		i++
+	}
+
+	// The extension subtags, a singleton other than "x" followed by subtags of 2 to 8 characters. A singleton must not repeat.
+	singletons := map[string]bool{}
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+		if singletons[subtags[i]] {
+			return false
+		}
+		singletons[subtags[i]] = true
+		i++
+
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+
+	if i < len(subtags) {
+		return isPrivateUse(subtags[i:])
+	}
+
+	return true
+}
+
+// isPrivateUse validate the "x" singleton followed by subtags of 1 to 8 characters.
+func isPrivateUse(subtags []string) bool {
+	return subtags[0] == "x" && len(subtags) > 1
+}
+
+func isLetters(value string) bool {
+	return isAlphabet(value, "abcdefghijklmnopqrstuvwxyz")
+}
+
+func isDigits(value string) bool {
+	return isAlphabet(value, "0123456789")
+}
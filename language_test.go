@@ -0,0 +1,36 @@
+package gosch
+
+import "testing"
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{value: "en", valid: true},
+		{value: "en-US", valid: true},
+		{value: "zh-Hant-TW", valid: true},
+		{value: "sl-rozaj-biske", valid: true},
+		{value: "de-CH-1901", valid: true},
+		{value: "en-a-myext-b-another", valid: true},
+		{value: "x-whatever", valid: true},
+		{value: "i-klingon", valid: true},
+		{value: "EN-us", valid: true},
+		{value: "", valid: false},
+		{value: "e", valid: false},
+		{value: "en-", valid: false},
+		{value: "en--US", valid: false},
+		{value: "de-DE-1901-1901", valid: false},
+		{value: "\u212Ao", valid: false}, // Kelvin sign lowercase to "k"
+		{value: "en-\u212A\u212A", valid: false},
+		{value: "\u0130t", valid: false}, // dotted capital I lowercase to "i" and a combining dot
+		{value: "\u017Fl", valid: false},
+	}
+
+	for _, test := range tests {
+		err := String().LanguageTag().Validate(test.value)
+		if got := err == nil; got != test.valid {
+			t.Errorf("LanguageTag().Validate(%q) = %v, want valid %v", test.value, err, test.valid)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package gosch
+
+import (
+	"reflect"
+	"slices"
+	"unicode/utf8"
+)
+
+// LengthUnit is the unit used to measure the length of a string.
+type LengthUnit uint
+
+const (
+	// UnitBytes count the bytes of a string, same as len.
+	UnitBytes LengthUnit = iota
+	// UnitRunes count the runes (Unicode code points) of a string.
+	UnitRunes
+	// UnitGraphemes count the user-perceived characters (extended grapheme clusters) of a string.
+	UnitGraphemes
+)
+
+func (unit LengthUnit) String() string {
+	switch unit {
+	case UnitBytes:
+		return "bytes"
+	case UnitRunes:
+		return "runes"
+	case UnitGraphemes:
+		return "characters"
+	default:
+		return "unknown"
+	}
+}
+
+func (unit LengthUnit) length(value string) int {
+	switch unit {
+	case UnitRunes:
+		return utf8.RuneCountInString(value)
+	case UnitGraphemes:
+		return graphemeCount(value)
+	default:
+		return len(value)
+	}
+}
+
+// LengthRule validate the length of a reflected slice or map without copying its elements.
+type LengthRule func(value reflect.Value) error
+
+func minLengthRule(length uint) LengthRule {
+	return func(value reflect.Value) error {
+		if value.Len() < int(length) {
+			return RuleError{
+				Name:   RuleMinLength,
+				Value:  value.Interface(),
+				Params: []any{length},
+			}
+		}
+		return nil
+	}
+}
+
+func maxLengthRule(length uint) LengthRule {
+	return func(value reflect.Value) error {
+		if value.Len() > int(length) {
+			return RuleError{
+				Name:   RuleMaxLength,
+				Value:  value.Interface(),
+				Params: []any{length},
+			}
+		}
+		return nil
+	}
+}
+
+func notLengthRule(lengths []uint) LengthRule {
+	lengths = slices.Clone(lengths)
+
+	return func(value reflect.Value) error {
+		if slices.Contains(lengths, uint(value.Len())) {
+			return RuleError{
+				Name:   RuleNotLength,
+				Value:  value.Interface(),
+				Params: []any{lengths},
+			}
+		}
+		return nil
+	}
+}
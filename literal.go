@@ -0,0 +1,59 @@
+package gosch
+
+import "reflect"
+
+type LiteralSchema[T comparable] struct {
+	nilable bool
+	value   T
+}
+
+// Literal validate that the input is equal to the value, e.g. Literal(2) for a version field or Literal("Deployment") for a kind field.
+// Pointers, interfaces, arrays and structs are compared with reflect.DeepEqual, so Literal(big.NewInt(7)) pass another big.NewInt(7).
+// If the input is not a T or not equal to the value, it will return an error.
+func Literal[T comparable](value T) LiteralSchema[T] {
+	return LiteralSchema[T]{
+		nilable: false,
+		value:   value,
+	}
+}
+
+// Nil will pass nil input.
+func (literalSchema LiteralSchema[T]) Nil() LiteralSchema[T] {
+	literalSchema.nilable = true
+	return literalSchema
+}
+
+// Value return the expected value, e.g. to use the literal as a discriminator.
+func (literalSchema LiteralSchema[T]) Value() T {
+	return literalSchema.value
+}
+
+func (literalSchema LiteralSchema[T]) Validate(value any) error {
+	literalValue, ok, err := typed[T](value)
+	if err != nil || !ok {
+		if !ok && literalSchema.nilable {
+			return nil
+		}
+		return err
+	}
+
+	if !literalSchema.equal(literalValue) {
+		return RuleError{
+			Name:   RuleLiteral,
+			Value:  literalValue,
+			Params: []any{literalSchema.value},
+		}
+	}
+
+	return nil
+}
+
+func (literalSchema LiteralSchema[T]) equal(value T) bool {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Array, reflect.Struct:
+		// == compare pointers by identity, also inside arrays and structs.
+		return reflect.DeepEqual(value, literalSchema.value)
+	default:
+		return value == literalSchema.value
+	}
+}
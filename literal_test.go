@@ -0,0 +1,43 @@
+package gosch
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLiteral(t *testing.T) {
+	type version struct {
+		Major *int
+	}
+
+	one, anotherOne, two := 1, 1, 2
+
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		valid  bool
+	}{
+		{name: "int", schema: Literal(2), value: 2, valid: true},
+		{name: "int mismatch", schema: Literal(2), value: 3, valid: false},
+		{name: "string", schema: Literal("Deployment"), value: "Deployment", valid: true},
+		{name: "pointer", schema: Literal(big.NewInt(7)), value: big.NewInt(7), valid: true},
+		{name: "pointer mismatch", schema: Literal(big.NewInt(7)), value: big.NewInt(8), valid: false},
+		{name: "array", schema: Literal([2]*int{&one, &two}), value: [2]*int{&anotherOne, &two}, valid: true},
+		{name: "array mismatch", schema: Literal([2]*int{&one, &two}), value: [2]*int{&two, &one}, valid: false},
+		{name: "struct", schema: Literal(version{Major: &one}), value: version{Major: &anotherOne}, valid: true},
+		{name: "struct mismatch", schema: Literal(version{Major: &one}), value: version{Major: &two}, valid: false},
+		{name: "interface", schema: Literal[any](big.NewInt(7)), value: big.NewInt(7), valid: true},
+		{name: "interface mismatch", schema: Literal[any](big.NewInt(7)), value: "7", valid: false},
+		{name: "wrong type", schema: Literal(2), value: "2", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type MapRule func(value map[any]any) error
 
@@ -8,7 +11,9 @@ type MapSchema struct {
 	nilable bool
 	key     Schema
 	element Schema
+	lengths []LengthRule
 	rules   []MapRule
+	workers int
 }
 
 // Map validate data type of the input.
@@ -18,7 +23,9 @@ func Map() MapSchema {
 		nilable: false,
 		key:     nil,
 		element: nil,
+		lengths: []LengthRule{},
 		rules:   []MapRule{},
+		workers: 1,
 	}
 }
 
@@ -44,19 +51,22 @@ func (mapSchema MapSchema) Element(schema Schema) MapSchema {
 	return mapSchema
 }
 
+// Concurrent validate the keys and elements of a map in parallel using at most the given number of workers.
+// The returned error is the one of the lowest failing key, same as the sequential validation.
+func (mapSchema MapSchema) Concurrent(workers int) MapSchema {
+	if workers < 1 {
+		panic("map workers must be greater than 0")
+	}
+
+	mapSchema.workers = workers
+
+	return mapSchema
+}
+
 // MinLength validate the minimum length of a map.
 // If the input is less than the minimum length, it will return an error.
 func (mapSchema MapSchema) MinLength(length uint) MapSchema {
-	mapSchema.rules = append(mapSchema.rules, func(value map[any]any) error {
-		if len(value) < int(length) {
-			return RuleError{
-				Name:   RuleMinLength,
-				Value:  value,
-				Params: []any{length},
-			}
-		}
-		return nil
-	})
+	mapSchema.lengths = append(slices.Clip(mapSchema.lengths), minLengthRule(length))
 
 	return mapSchema
 }
@@ -64,16 +74,32 @@ func (mapSchema MapSchema) MinLength(length uint) MapSchema {
 // MaxLength validate the maximum length of a map.
 // If the input is greater than the maximum length, it will return an error.
 func (mapSchema MapSchema) MaxLength(length uint) MapSchema {
-	mapSchema.rules = append(mapSchema.rules, func(value map[any]any) error {
-		if len(value) > int(length) {
-			return RuleError{
-				Name:   RuleMaxLength,
-				Value:  value,
-				Params: []any{length},
-			}
-		}
-		return nil
-	})
+	mapSchema.lengths = append(slices.Clip(mapSchema.lengths), maxLengthRule(length))
+
+	return mapSchema
+}
+
+// NotLength validate that the length of a map is not one of the lengths.
+// If the input length is equal to any of the lengths, it will return an error.
+func (mapSchema MapSchema) NotLength(lengths ...uint) MapSchema {
+	if len(lengths) == 0 {
+		panic("map lengths must not be empty")
+	}
+
+	mapSchema.lengths = append(slices.Clip(mapSchema.lengths), notLengthRule(lengths))
+
+	return mapSchema
+}
+
+// Rule add a custom rule that validate the whole map, e.g. that two keys are not present together.
+// The rule receive a copy of the entries, which is only made when the schema has a rule.
+// If the rule return an error, it will return the error.
+func (mapSchema MapSchema) Rule(rule MapRule) MapSchema {
+	if rule == nil {
+		panic("map rule must not be nil")
+	}
+
+	mapSchema.rules = append(slices.Clip(mapSchema.rules), rule)
 
 	return mapSchema
 }
@@ -105,28 +131,72 @@ func (mapSchema MapSchema) Validate(value any) error {
 		}
 	}
 
-	mapValue := make(map[any]any, reflectedValue.Len())
+	for _, rule := range mapSchema.lengths {
+		if err := rule(reflectedValue); err != nil {
+			return err
+		}
+	}
 
-	for key, element := range reflectedValue.Seq2() {
-		keyValue := key.Interface()
-		elementValue := element.Interface()
+	validateEntry := func(key reflect.Value, element reflect.Value) error {
+		if mapSchema.key != nil {
+			if err := mapSchema.key.Validate(key.Interface()); err != nil {
+				return KeyError{
+					Key: key.Interface(),
+					Err: err,
+				}
+			}
+		}
 
-		if err := mapSchema.key.Validate(keyValue); err != nil {
-			return KeyError{
-				Key: keyValue,
-				Err: err,
+		if mapSchema.element != nil {
+			if err := mapSchema.element.Validate(element.Interface()); err != nil {
+				return ElementError{
+					Index: key.Interface(),
+					Value: element,
+					Err:   err,
+				}
 			}
 		}
 
-		if err := mapSchema.element.Validate(elementValue); err != nil {
-			return ElementError{
-				Index: keyValue,
-				Value: element,
-				Err:   err,
+		return nil
+	}
+
+	if mapSchema.key != nil || mapSchema.element != nil {
+		if mapSchema.workers > 1 {
+			// The entries are sorted, so the first error does not depend on the map order or the workers.
+			entries := sortedEntries(reflectedValue)
+
+			err := validateConcurrently(len(entries), mapSchema.workers, func(i int) error {
+				return validateEntry(entries[i].key, entries[i].element)
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			iterator := reflectedValue.MapRange()
+			for iterator.Next() {
+				if err := validateEntry(iterator.Key(), iterator.Value()); err != nil {
+					// The map order is random, so the entries are only sorted on failure
+					// to return the error of the lowest failing key, same as the concurrent path.
+					for _, entry := range sortedEntries(reflectedValue) {
+						if err := validateEntry(entry.key, entry.element); err != nil {
+							return err
+						}
+					}
+
+					return err
+				}
 			}
 		}
+	}
 
-		mapValue[keyValue] = elementValue
+	if len(mapSchema.rules) == 0 {
+		return nil
+	}
+
+	// The entries are only copied when there is a rule that needs the whole map.
+	mapValue := make(map[any]any, reflectedValue.Len())
+	for key, element := range reflectedValue.Seq2() {
+		mapValue[key.Interface()] = element.Interface()
 	}
 
 	for _, rule := range mapSchema.rules {
@@ -0,0 +1,104 @@
+package gosch
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func BenchmarkMapValidate(b *testing.B) {
+	value := make(map[string]int, 1000)
+	for i := range 1000 {
+		value[strconv.Itoa(i)] = i
+	}
+
+	benchmarks := []struct {
+		name   string
+		schema MapSchema
+	}{
+		{name: "Length", schema: Map().MinLength(1).MaxLength(1000)},
+		{name: "Element", schema: Map().MinLength(1).Key(String().NotEmpty()).Element(Int().MinValue(0))},
+		{name: "Rule", schema: Map().Rule(func(value map[any]any) error { return nil })},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				if err := benchmark.schema.Validate(value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestMapRule(t *testing.T) {
+	errExclusive := errors.New("exclusive")
+
+	schema := Map().Rule(func(value map[any]any) error {
+		_, hasID := value["id"]
+		_, hasName := value["name"]
+		if hasID && hasName {
+			return errExclusive
+		}
+		return nil
+	})
+
+	if err := schema.Validate(map[string]int{"id": 1}); err != nil {
+		t.Errorf("Validate({id}) = %v, want nil", err)
+	}
+	if err := schema.Validate(map[string]int{"id": 1, "name": 2}); !errors.Is(err, errExclusive) {
+		t.Errorf("Validate({id name}) = %v, want %v", err, errExclusive)
+	}
+}
+
+func TestMapNaNKey(t *testing.T) {
+	value := map[float64]int{math.NaN(): 1, 2: 2}
+
+	for _, workers := range []int{1, 4} {
+		if err := Map().Element(Int()).Concurrent(workers).Validate(value); err != nil {
+			t.Errorf("Concurrent(%d).Validate(NaN key) = %v, want nil", workers, err)
+		}
+		if err := Map().Element(Int().MinValue(2)).Concurrent(workers).Validate(value); err == nil {
+			t.Errorf("Concurrent(%d).Validate(NaN key) = nil, want an error", workers)
+		}
+	}
+}
+
+func TestMapLowestKeyError(t *testing.T) {
+	value := map[any]any{}
+	for i := range 100 {
+		value[i] = i
+	}
+	value[40] = "forty"
+	value[70] = "seventy"
+	value["key"] = "value"
+
+	want := Map().Element(Int()).Concurrent(4).Validate(value)
+	if want == nil {
+		t.Fatal("Validate() = nil, want an error")
+	}
+
+	for range 20 {
+		if got := Map().Element(Int()).Validate(value); got == nil || got.Error() != want.Error() {
+			t.Fatalf("Validate() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMapAllocations(t *testing.T) {
+	value := make(map[any]any, 1000)
+	for i := range 1000 {
+		value[i] = i
+	}
+
+	schema := Map().Key(Int()).Element(Int())
+
+	// The keys are only sorted on failure, a valid map cost about the allocations of MapRange.
+	if allocs := testing.AllocsPerRun(10, func() { _ = schema.Validate(value) }); allocs > 2500 {
+		t.Errorf("Validate() allocations = %v, want at most 2500", allocs)
+	}
+}
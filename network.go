@@ -0,0 +1,374 @@
+package gosch
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// loopbackPrefixes contain the loopback ranges rejected by DenyLoopback.
+	loopbackPrefixes = embeddedPrefixes(
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+		// Every IPv4-mapped address, a wider IPv6 prefix that cover them cover 127.0.0.0/8 too.
+		netip.MustParsePrefix("::ffff:0:0/96"),
+	)
+	// privatePrefixes contain the ranges rejected by DenyPrivate: private, shared, link-local, reserved, unspecified and NAT64 addresses.
+	privatePrefixes = embeddedPrefixes(
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("169.254.0.0/16"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		netip.MustParsePrefix("224.0.0.0/24"),
+		// Reserved addresses, including the broadcast address 255.255.255.255.
+		netip.MustParsePrefix("240.0.0.0/4"),
+		netip.MustParsePrefix("::/128"),
+		netip.MustParsePrefix("::ffff:0:0/96"),
+		netip.MustParsePrefix("64:ff9b::/96"),
+		netip.MustParsePrefix("fc00::/7"),
+		netip.MustParsePrefix("fe80::/10"),
+		// Deprecated site-local addresses, still routed by some networks.
+		netip.MustParsePrefix("fec0::/10"),
+		netip.MustParsePrefix("ff02::/16"),
+	)
+)
+
+// embeddedPrefixes return the prefixes with the IPv6 forms that embed each IPv4 prefix,
+// the 6to4 prefix, e.g. 2002:7f00::/24 for 127.0.0.0/8, and the deprecated IPv4-compatible prefix, e.g. ::7f00:0/104.
+func embeddedPrefixes(prefixes ...netip.Prefix) []netip.Prefix {
+	embedded := slices.Clone(prefixes)
+
+	for _, prefix := range prefixes {
+		if !prefix.Addr().Is4() {
+			continue
+		}
+
+		ipv4 := prefix.Addr().As4()
+
+		var sixToFour [16]byte
+		sixToFour[0], sixToFour[1] = 0x20, 0x02
+		copy(sixToFour[2:6], ipv4[:])
+
+		var compatible [16]byte
+		copy(compatible[12:], ipv4[:])
+
+		embedded = append(embedded,
+			netip.PrefixFrom(netip.AddrFrom16(sixToFour), 16+prefix.Bits()),
+			netip.PrefixFrom(netip.AddrFrom16(compatible), 96+prefix.Bits()),
+		)
+	}
+
+	return embedded
+}
+
+// NetworkOptions configure the network rules and their parse functions.
+// The zero value accept any address and ASCII host names only.
+// The deny options only check literal addresses, host names are not resolved.
+type NetworkOptions struct {
+	// AllowIDN accept internationalized host names, e.g. "bücher.example".
+	AllowIDN bool
+	// DenyPrivate reject private, shared, link-local, reserved, unspecified and NAT64 addresses, e.g. "10.0.0.1", "100.64.0.1", "169.254.169.254" or "::".
+	// The deny options also reject the denied IPv4 addresses embedded in 6to4 and IPv4-compatible addresses, e.g. "2002:7f00:1::" or "::127.0.0.1".
+	DenyPrivate bool
+	// DenyLoopback reject loopback addresses and the "localhost" host names, e.g. "127.0.0.1" or "api.localhost".
+	DenyLoopback bool
+}
+
+// IP validate that a string is an IPv4 or IPv6 address, e.g. "192.0.2.1" or "2001:db8::1".
+// If the input is not a valid IP address, it will return an error.
+func (stringSchema StringSchema) IP(options ...NetworkOptions) StringSchema {
+	return stringSchema.ip(RuleIP, firstOption(options))
+}
+
+// IPv4 validate that a string is an IPv4 address in the dotted decimal form, e.g. "192.0.2.1".
+// If the input is not a valid IPv4 address, it will return an error.
+func (stringSchema StringSchema) IPv4(options ...NetworkOptions) StringSchema {
+	return stringSchema.ip(RuleIPv4, firstOption(options))
+}
+
+// IPv6 validate that a string is an IPv6 address, e.g. "2001:db8::1".
+// If the input is not a valid IPv6 address, it will return an error.
+func (stringSchema StringSchema) IPv6(options ...NetworkOptions) StringSchema {
+	return stringSchema.ip(RuleIPv6, firstOption(options))
+}
+
+func (stringSchema StringSchema) ip(name RuleName, options NetworkOptions) StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseIP(value, name, options)
+		return err
+	})
+
+	return stringSchema
+}
+
+// CIDR validate that a string is an IP address prefix in the CIDR notation, e.g. "192.0.2.0/24".
+// The deny options reject a prefix that overlap any denied range, e.g. "8.0.0.0/6" contain 10.0.0.0/8.
+// If the input is not a valid CIDR, it will return an error.
+func (stringSchema StringSchema) CIDR(options ...NetworkOptions) StringSchema {
+	networkOptions := firstOption(options)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseCIDR(value, networkOptions)
+		return err
+	})
+
+	return stringSchema
+}
+
+// Hostname validate that a string is a host name as defined by RFC 1123, e.g. "localhost" or "api.example.com".
+// If the input is not a valid host name, it will return an error.
+func (stringSchema StringSchema) Hostname(options ...NetworkOptions) StringSchema {
+	networkOptions := firstOption(options)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !isHostname(value, networkOptions.AllowIDN) {
+			return RuleError{
+				Name:  RuleHostname,
+				Value: value,
+			}
+		}
+		return deniedHost(value, value, networkOptions)
+	})
+
+	return stringSchema
+}
+
+// FQDN validate that a string is a fully qualified domain name, a host name with a top-level domain and an optional trailing dot, e.g. "api.example.com.".
+// If the input is not a valid FQDN, it will return an error.
+func (stringSchema StringSchema) FQDN(options ...NetworkOptions) StringSchema {
+	networkOptions := firstOption(options)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		hostname := strings.TrimSuffix(value, ".")
+		if !strings.Contains(hostname, ".") || !isHostname(hostname, networkOptions.AllowIDN) {
+			return RuleError{
+				Name:  RuleFQDN,
+				Value: value,
+			}
+		}
+		return deniedHost(value, hostname, networkOptions)
+	})
+
+	return stringSchema
+}
+
+// URL validate that a string is an absolute URL with a host, e.g. "https://example.com/path".
+// The scheme must be one of the schemes, compared case-insensitively, or any scheme if there are none, e.g. URL([]string{"https"}).
+// If the input is not a valid URL, it will return an error.
+func (stringSchema StringSchema) URL(schemes []string, options ...NetworkOptions) StringSchema {
+	networkOptions := firstOption(options)
+	schemes = slices.Clone(schemes)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parseURL(value, schemes, networkOptions)
+		return err
+	})
+
+	return stringSchema
+}
+
+// MAC validate that a string is an IEEE 802 MAC address in a form accepted by net.ParseMAC, e.g. "00:00:5e:00:53:01".
+// If the input is not a valid MAC address, it will return an error.
+func (stringSchema StringSchema) MAC() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if _, err := net.ParseMAC(value); err != nil {
+			return RuleError{
+				Name:  RuleMAC,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// HostPort validate that a string is a host name or IP address with a port from 1 to 65535, e.g. "example.com:443" or "[2001:db8::1]:8080".
+// If the input is not a valid host and port, it will return an error.
+func (stringSchema StringSchema) HostPort(options ...NetworkOptions) StringSchema {
+	networkOptions := firstOption(options)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		ruleError := RuleError{
+			Name:  RuleHostPort,
+			Value: value,
+		}
+
+		host, port, err := net.SplitHostPort(value)
+		if err != nil || !isPort(port) {
+			return ruleError
+		}
+
+		valid, err := validateHost(value, host, networkOptions)
+		if !valid {
+			return ruleError
+		}
+
+		return err
+	})
+
+	return stringSchema
+}
+
+// ParseIP validate the string like the IP rule and return the parsed address.
+func ParseIP(value string, options ...NetworkOptions) (netip.Addr, error) {
+	return parseIP(value, RuleIP, firstOption(options))
+}
+
+// ParseCIDR validate the string like the CIDR rule and return the parsed prefix.
+func ParseCIDR(value string, options ...NetworkOptions) (netip.Prefix, error) {
+	return parseCIDR(value, firstOption(options))
+}
+
+// ParseURL validate the string like the URL rule with any scheme and return the parsed URL.
+func ParseURL(value string, options ...NetworkOptions) (*url.URL, error) {
+	return parseURL(value, nil, firstOption(options))
+}
+
+func parseIP(value string, name RuleName, options NetworkOptions) (netip.Addr, error) {
+	address, err := netip.ParseAddr(value)
+	if err != nil || (name == RuleIPv4 && !address.Is4()) || (name == RuleIPv6 && !address.Is6()) {
+		return netip.Addr{}, RuleError{
+			Name:  name,
+			Value: value,
+		}
+	}
+
+	if err := deniedAddress(value, address, options); err != nil {
+		return netip.Addr{}, err
+	}
+
+	return address, nil
+}
+
+func parseCIDR(value string, options NetworkOptions) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, RuleError{
+			Name:  RuleCIDR,
+			Value: value,
+		}
+	}
+
+	if err := deniedPrefix(value, prefix.Masked(), options); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix, nil
+}
+
+func parseURL(value string, schemes []string, options NetworkOptions) (*url.URL, error) {
+	ruleError := RuleError{
+		Name:   RuleURL,
+		Value:  value,
+		Params: []any{schemes},
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, ruleError
+	}
+
+	if len(schemes) > 0 && !slices.ContainsFunc(schemes, func(scheme string) bool {
+		return strings.EqualFold(scheme, parsed.Scheme)
+	}) {
+		return nil, ruleError
+	}
+
+	if port := parsed.Port(); port != "" && !isPort(port) {
+		return nil, ruleError
+	}
+
+	valid, err := validateHost(value, parsed.Hostname(), options)
+	if !valid {
+		return nil, ruleError
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// validateHost validate the host of a URL or a host and port, which is an IP address or a host name.
+// It return false if the host is neither, and an error if the host is rejected by the deny options.
+func validateHost(value string, host string, options NetworkOptions) (bool, error) {
+	if address, err := netip.ParseAddr(host); err == nil {
+		return true, deniedAddress(value, address, options)
+	}
+
+	if !isHostname(host, options.AllowIDN) {
+		return false, nil
+	}
+
+	return true, deniedHost(value, host, options)
+}
+
+// deniedAddress return an error if the address is rejected by the deny options.
+// Zoned addresses, e.g. "fe80::1%eth0", are rejected by any deny option because the zone select the interface.
+func deniedAddress(value string, address netip.Addr, options NetworkOptions) error {
+	if (options.DenyPrivate || options.DenyLoopback) && address.Zone() != "" {
+		return RuleError{
+			Name:   RuleDeniedAddress,
+			Value:  value,
+			Params: []any{"zoned"},
+		}
+	}
+
+	return deniedPrefix(value, netip.PrefixFrom(address, address.BitLen()), options)
+}
+
+// deniedPrefix return an error if the prefix overlap a range that is rejected by the deny options.
+func deniedPrefix(value string, prefix netip.Prefix, options NetworkOptions) error {
+	// IPv4-mapped IPv6 prefixes are checked as the IPv4 prefix they contain.
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+
+	overlaps := func(denied []netip.Prefix) bool {
+		return slices.ContainsFunc(denied, prefix.Overlaps)
+	}
+
+	switch {
+	case options.DenyLoopback && overlaps(loopbackPrefixes):
+		return RuleError{
+			Name:   RuleDeniedAddress,
+			Value:  value,
+			Params: []any{"loopback"},
+		}
+	case options.DenyPrivate && overlaps(privatePrefixes):
+		return RuleError{
+			Name:   RuleDeniedAddress,
+			Value:  value,
+			Params: []any{"private"},
+		}
+	default:
+		return nil
+	}
+}
+
+// deniedHost return an error if the host name is a loopback name as defined by RFC 6761 and the loopback addresses are denied.
+func deniedHost(value string, hostname string, options NetworkOptions) error {
+	hostname = strings.ToLower(hostname)
+
+	if options.DenyLoopback && (hostname == "localhost" || strings.HasSuffix(hostname, ".localhost")) {
+		return RuleError{
+			Name:   RuleDeniedAddress,
+			Value:  value,
+			Params: []any{"loopback"},
+		}
+	}
+
+	return nil
+}
+
+func isPort(port string) bool {
+	number, err := strconv.ParseUint(port, 10, 16)
+	return err == nil && number > 0
+}
@@ -0,0 +1,61 @@
+package gosch
+
+import "testing"
+
+func TestNetworkDeny(t *testing.T) {
+	deny := NetworkOptions{DenyPrivate: true, DenyLoopback: true}
+
+	tests := []struct {
+		name   string
+		schema StringSchema
+		value  string
+		want   string
+	}{
+		{name: "public IP", schema: String().IP(deny), value: "8.8.8.8", want: ""},
+		{name: "private IP", schema: String().IP(deny), value: "10.1.2.3", want: "private"},
+		{name: "shared IP", schema: String().IP(deny), value: "100.64.0.1", want: "private"},
+		{name: "this network IP", schema: String().IP(deny), value: "0.1.2.3", want: "private"},
+		{name: "metadata IP", schema: String().IP(deny), value: "169.254.169.254", want: "private"},
+		{name: "NAT64 IP", schema: String().IP(deny), value: "64:ff9b::a00:1", want: "private"},
+		{name: "mapped loopback IP", schema: String().IP(deny), value: "::ffff:127.0.0.1", want: "loopback"},
+		{name: "mapped public IP", schema: String().IP(deny), value: "::ffff:8.8.8.8", want: ""},
+		{name: "6to4 loopback IP", schema: String().IP(deny), value: "2002:7f00:1::", want: "loopback"},
+		{name: "6to4 private IP", schema: String().IP(deny), value: "2002:c0a8:101::1", want: "private"},
+		{name: "6to4 public IP", schema: String().IP(deny), value: "2002:808:808::1", want: ""},
+		{name: "IPv4-compatible loopback IP", schema: String().IP(deny), value: "::127.0.0.1", want: "loopback"},
+		{name: "IPv4-compatible private IP", schema: String().IP(deny), value: "::10.0.0.1", want: "private"},
+		{name: "broadcast IP", schema: String().IP(deny), value: "255.255.255.255", want: "private"},
+		{name: "reserved IP", schema: String().IP(deny), value: "240.0.0.1", want: "private"},
+		{name: "site-local IP", schema: String().IP(deny), value: "fec0::1", want: "private"},
+		{name: "public IPv6", schema: String().IP(deny), value: "2001:4860:4860::8888", want: ""},
+		{name: "zoned IP", schema: String().IP(deny), value: "fe80::1%eth0", want: "zoned"},
+		{name: "zoned IP allowed", schema: String().IP(), value: "fe80::1%eth0", want: ""},
+		{name: "public CIDR", schema: String().CIDR(deny), value: "8.8.8.0/24", want: ""},
+		{name: "CIDR covering private", schema: String().CIDR(deny), value: "8.0.0.0/6", want: "private"},
+		{name: "CIDR covering loopback", schema: String().CIDR(deny), value: "126.0.0.0/7", want: "loopback"},
+		{name: "CIDR covering everything", schema: String().CIDR(deny), value: "0.0.0.0/0", want: "loopback"},
+		{name: "IPv6 CIDR covering mapped", schema: String().CIDR(deny), value: "::/64", want: "loopback"},
+		{name: "6to4 CIDR covering loopback", schema: String().CIDR(deny), value: "2002::/16", want: "loopback"},
+		{name: "mapped CIDR", schema: String().CIDR(deny), value: "::ffff:10.0.0.0/104", want: "private"},
+		{name: "URL metadata", schema: String().URL(nil, deny), value: "http://169.254.169.254/latest", want: "private"},
+		{name: "HostPort localhost", schema: String().HostPort(deny), value: "localhost:80", want: "loopback"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+
+			if test.want == "" {
+				if err != nil {
+					t.Fatalf("Validate(%q) = %v, want nil", test.value, err)
+				}
+				return
+			}
+
+			ruleError, ok := err.(RuleError)
+			if !ok || ruleError.Name != RuleDeniedAddress || ruleError.Params[0] != test.want {
+				t.Fatalf("Validate(%q) = %v, want a %s address error", test.value, err, test.want)
+			}
+		})
+	}
+}
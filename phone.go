@@ -0,0 +1,172 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+// phoneRegion is the numbering plan metadata of a region.
+type phoneRegion struct {
+	// code is the country calling code.
+	code string
+	// trunk is the prefix dialed before a national number inside the region.
+	trunk string
+	// lengths is the accepted lengths of the national significant number.
+	lengths []int
+	// trunkNumbers report whether a national significant number may start with the trunk prefix, e.g. the "8" of toll-free numbers in RU.
+	trunkNumbers bool
+}
+
+// phoneRegions map ISO 3166-1 alpha-2 region codes to their numbering plan.
+var phoneRegions = map[string]phoneRegion{
+	"AE": {code: "971", trunk: "0", lengths: []int{8, 9}},
+	"AR": {code: "54", trunk: "0", lengths: []int{10}},
+	"AT": {code: "43", trunk: "0", lengths: []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}},
+	"AU": {code: "61", trunk: "0", lengths: []int{9}},
+	"BD": {code: "880", trunk: "0", lengths: []int{10}},
+	"BE": {code: "32", trunk: "0", lengths: []int{8, 9}},
+	"BR": {code: "55", trunk: "0", lengths: []int{10, 11}},
+	"CA": {code: "1", trunk: "1", lengths: []int{10}},
+	"CH": {code: "41", trunk: "0", lengths: []int{9}},
+	"CL": {code: "56", trunk: "", lengths: []int{9}},
+	"CN": {code: "86", trunk: "0", lengths: []int{9, 10, 11}},
+	"CO": {code: "57", trunk: "", lengths: []int{10}},
+	"DE": {code: "49", trunk: "0", lengths: []int{6, 7, 8, 9, 10, 11, 12, 13}},
+	"DK": {code: "45", trunk: "", lengths: []int{8}},
+	"EG": {code: "20", trunk: "0", lengths: []int{9, 10}},
+	"ES": {code: "34", trunk: "", lengths: []int{9}},
+	"FI": {code: "358", trunk: "0", lengths: []int{5, 6, 7, 8, 9, 10, 11, 12}},
+	"FR": {code: "33", trunk: "0", lengths: []int{9}},
+	"GB": {code: "44", trunk: "0", lengths: []int{9, 10}},
+	"HK": {code: "852", trunk: "", lengths: []int{8}},
+	"ID": {code: "62", trunk: "0", lengths: []int{8, 9, 10, 11, 12}},
+	"IE": {code: "353", trunk: "0", lengths: []int{7, 8, 9}},
+	"IL": {code: "972", trunk: "0", lengths: []int{8, 9}},
+	"IN": {code: "91", trunk: "0", lengths: []int{10}},
+	"IT": {code: "39", trunk: "", lengths: []int{6, 7, 8, 9, 10, 11}},
+	"JP": {code: "81", trunk: "0", lengths: []int{9, 10}},
+	"KE": {code: "254", trunk: "0", lengths: []int{9}},
+	"KR": {code: "82", trunk: "0", lengths: []int{8, 9, 10}},
+	"KZ": {code: "7", trunk: "8", lengths: []int{10}, trunkNumbers: true},
+	"MX": {code: "52", trunk: "", lengths: []int{10}},
+	"MY": {code: "60", trunk: "0", lengths: []int{9, 10}},
+	"NG": {code: "234", trunk: "0", lengths: []int{8, 10}},
+	"NL": {code: "31", trunk: "0", lengths: []int{9}},
+	"NO": {code: "47", trunk: "", lengths: []int{8}},
+	"NZ": {code: "64", trunk: "0", lengths: []int{8, 9, 10}},
+	"PE": {code: "51", trunk: "0", lengths: []int{8, 9}},
+	"PH": {code: "63", trunk: "0", lengths: []int{10}},
+	"PK": {code: "92", trunk: "0", lengths: []int{9, 10}},
+	"PL": {code: "48", trunk: "", lengths: []int{9}},
+	"PT": {code: "351", trunk: "", lengths: []int{9}},
+	"RU": {code: "7", trunk: "8", lengths: []int{10}, trunkNumbers: true},
+	"SA": {code: "966", trunk: "0", lengths: []int{9}},
+	"SE": {code: "46", trunk: "0", lengths: []int{7, 8, 9, 10}},
+	"SG": {code: "65", trunk: "", lengths: []int{8}},
+	"TH": {code: "66", trunk: "0", lengths: []int{8, 9}},
+	"TR": {code: "90", trunk: "0", lengths: []int{10}},
+	"TW": {code: "886", trunk: "0", lengths: []int{8, 9}},
+	"UA": {code: "380", trunk: "0", lengths: []int{9}},
+	"US": {code: "1", trunk: "1", lengths: []int{10}},
+	"VN": {code: "84", trunk: "0", lengths: []int{9, 10}},
+	"ZA": {code: "27", trunk: "0", lengths: []int{9}},
+}
+
+// Phone validate that a string is a phone number of a known region.
+// Numbers in the international format ("+" or "00" followed by the calling code) are accepted for every region,
+// numbers in the national format are only accepted for the optional default region.
+// It will panic if the region is unknown.
+// If the input is not a valid phone number, it will return an error.
+func (stringSchema StringSchema) Phone(region ...string) StringSchema {
+	defaultRegion := strings.ToUpper(firstOption(region))
+	if _, ok := phoneRegions[defaultRegion]; defaultRegion != "" && !ok {
+		panic("unknown phone region " + defaultRegion)
+	}
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		_, err := parsePhone(value, defaultRegion)
+		return err
+	})
+
+	return stringSchema
+}
+
+// ParsePhone validate the phone number like the Phone rule and return its E.164 form, e.g. "+6281234567890".
+func ParsePhone(value string, region ...string) (string, error) {
+	return parsePhone(value, strings.ToUpper(firstOption(region)))
+}
+
+func parsePhone(value string, defaultRegion string) (string, error) {
+	ruleError := RuleError{
+		Name:  RulePhone,
+		Value: value,
+	}
+
+	international := false
+	digits := make([]byte, 0, len(value))
+	for i, r := range strings.TrimSpace(value) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, byte(r))
+		case r == '+' && i == 0:
+			international = true
+		case r == ' ', r == '-', r == '.', r == '(', r == ')', r == '/':
+		default:
+			return "", ruleError
+		}
+	}
+
+	number := string(digits)
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+
+	if international {
+		for codeLength := 1; codeLength <= 3 && codeLength < len(number); codeLength++ {
+			code, national := number[:codeLength], number[codeLength:]
+
+			for _, phoneRegion := range phoneRegions {
+				if phoneRegion.code != code {
+					continue
+				}
+
+				if national, ok := phoneRegion.national(national); ok {
+					return "+" + code + national, nil
+				}
+			}
+		}
+
+		return "", ruleError
+	}
+
+	phoneRegion, ok := phoneRegions[defaultRegion]
+	if !ok {
+		return "", ruleError
+	}
+
+	national, ok := phoneRegion.national(number)
+	if !ok {
+		return "", ruleError
+	}
+
+	return "+" + phoneRegion.code + national, nil
+}
+
+// national return the national significant number of the digits after the calling code or of a national format number.
+// The trunk prefix is removed when the rest has a valid length, e.g. "+62 0812..." is the same as "+62 812...".
+// Otherwise digits that start with the trunk prefix are rejected, unless the numbers of the region may start with it,
+// e.g. "+7 800..." is a toll-free number and not a trunk prefix.
+func (phoneRegion phoneRegion) national(number string) (string, bool) {
+	if phoneRegion.trunk != "" && strings.HasPrefix(number, phoneRegion.trunk) {
+		if national := number[len(phoneRegion.trunk):]; slices.Contains(phoneRegion.lengths, len(national)) {
+			return national, true
+		}
+
+		if !phoneRegion.trunkNumbers {
+			return "", false
+		}
+	}
+
+	return number, slices.Contains(phoneRegion.lengths, len(number))
+}
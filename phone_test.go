@@ -0,0 +1,45 @@
+package gosch
+
+import "testing"
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		value  string
+		region string
+		want   string
+	}{
+		{value: "+62 812-3456-7890", want: "+6281234567890"},
+		{value: "0062 812 3456 7890", want: "+6281234567890"},
+		{value: "0812-3456-7890", region: "ID", want: "+6281234567890"},
+		{value: "812-3456-7890", region: "id", want: "+6281234567890"},
+		{value: "+62 0812-3456-7890", want: "+6281234567890"},
+		{value: "+44 (0)20 7946 0958", want: "+442079460958"},
+		{value: "+1 (415) 555-2671", want: "+14155552671"},
+		{value: "1 415 555 2671", region: "US", want: "+14155552671"},
+		{value: "+7 800 555-35-35", want: "+78005553535"},
+		{value: "8 800 555-35-35", region: "RU", want: "+78005553535"},
+		{value: "+62 0812-3456-7", want: "+6281234567"},
+		{value: "+62 0812-3456", want: ""},
+		{value: "+1 1415 555 2671", want: "+14155552671"},
+		{value: "+1 141 555 2671", want: ""},
+		{value: "0812-3456-7890", want: ""},
+		{value: "+62 8", want: ""},
+		{value: "+999 1234567", want: ""},
+		{value: "+62 812 3456 7890 x12", want: ""},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePhone(test.value, test.region)
+
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("ParsePhone(%q, %q) = %q, want an error", test.value, test.region, got)
+			}
+			continue
+		}
+
+		if err != nil || got != test.want {
+			t.Errorf("ParsePhone(%q, %q) = %q, %v, want %q", test.value, test.region, got, err, test.want)
+		}
+	}
+}
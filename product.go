@@ -0,0 +1,79 @@
+package gosch
+
+import (
+	"slices"
+	"strings"
+)
+
+// EAN13 validate that a string is a 13 digit International Article Number with a valid check digit, e.g. "4006381333931".
+// If the input is not a valid EAN-13, it will return an error.
+func (stringSchema StringSchema) EAN13() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !isEAN13(value) {
+			return RuleError{
+				Name:  RuleEAN13,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// ISBN validate that a string is an ISBN-10 or ISBN-13 with a valid check digit, hyphens and spaces between the digits are ignored.
+// If the input is not a valid ISBN, it will return an error.
+func (stringSchema StringSchema) ISBN() StringSchema {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		isbn := strings.NewReplacer(" ", "", "-", "").Replace(value)
+
+		switch {
+		case len(isbn) == 10 && isISBN10(isbn):
+		case len(isbn) == 13 && (strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) && isEAN13(isbn):
+		default:
+			return RuleError{
+				Name:  RuleISBN,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// isEAN13 validate the check digit of an EAN-13, the digits are weighted 1 and 3 alternately.
+func isEAN13(value string) bool {
+	if len(value) != 13 || !isAlphabet(value, "0123456789") {
+		return false
+	}
+
+	sum := 0
+	for i := range len(value) {
+		digit := int(value[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
+
+// isISBN10 validate the check digit of an ISBN-10, the digits are weighted 10 to 1 and the check digit may be X for 10.
+func isISBN10(value string) bool {
+	if !isAlphabet(value[:9], "0123456789") || !isAlphabet(value[9:], "0123456789X") {
+		return false
+	}
+
+	sum := 0
+	for i := range len(value) {
+		digit := int(value[i] - '0')
+		if value[i] == 'X' {
+			digit = 10
+		}
+		sum += digit * (10 - i)
+	}
+
+	return sum%11 == 0
+}
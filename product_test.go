@@ -0,0 +1,37 @@
+package gosch
+
+import "testing"
+
+func TestProductCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema StringSchema
+		value  string
+		valid  bool
+	}{
+		{name: "EAN-13", schema: String().EAN13(), value: "4006381333931", valid: true},
+		{name: "EAN-13 wrong check digit", schema: String().EAN13(), value: "4006381333932", valid: false},
+		{name: "EAN-13 too short", schema: String().EAN13(), value: "400638133393", valid: false},
+		{name: "EAN-13 letters", schema: String().EAN13(), value: "400638133393A", valid: false},
+		{name: "ISBN-10", schema: String().ISBN(), value: "0306406152", valid: true},
+		{name: "ISBN-10 with X", schema: String().ISBN(), value: "080442957X", valid: true},
+		{name: "ISBN-10 with hyphens", schema: String().ISBN(), value: "0-8044-2957-X", valid: true},
+		{name: "ISBN-10 lowercase x", schema: String().ISBN(), value: "080442957x", valid: false},
+		{name: "ISBN-10 wrong check digit", schema: String().ISBN(), value: "0804429579", valid: false},
+		{name: "ISBN-10 X not last", schema: String().ISBN(), value: "08044295X7", valid: false},
+		{name: "ISBN-13", schema: String().ISBN(), value: "978-0-306-40615-7", valid: true},
+		{name: "ISBN-13 979", schema: String().ISBN(), value: "9791032300831", valid: true},
+		{name: "ISBN-13 wrong check digit", schema: String().ISBN(), value: "9780306406158", valid: false},
+		{name: "ISBN-13 not a book", schema: String().ISBN(), value: "4006381333931", valid: false},
+		{name: "ISBN wrong length", schema: String().ISBN(), value: "97803064061", valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%q) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
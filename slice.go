@@ -1,13 +1,18 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type SliceRule func(value []any) error
 
 type SliceSchema struct {
 	nilable bool
 	element Schema
+	lengths []LengthRule
 	rules   []SliceRule
+	workers int
 }
 
 // Slice validate data type of the input.
@@ -16,7 +21,9 @@ func Slice() SliceSchema {
 	return SliceSchema{
 		nilable: false,
 		element: nil,
+		lengths: []LengthRule{},
 		rules:   []SliceRule{},
+		workers: 1,
 	}
 }
 
@@ -34,19 +41,22 @@ func (sliceSchema SliceSchema) Element(schema Schema) SliceSchema {
 	return sliceSchema
 }
 
+// Concurrent validate the elements of a slice in parallel using at most the given number of workers.
+// The returned error is the one of the lowest failing index, same as the sequential validation.
+func (sliceSchema SliceSchema) Concurrent(workers int) SliceSchema {
+	if workers < 1 {
+		panic("slice workers must be greater than 0")
+	}
+
+	sliceSchema.workers = workers
+
+	return sliceSchema
+}
+
 // MinLength validate the minimum length of a slice.
 // If the input is less than the minimum length, it will return an error.
 func (sliceSchema SliceSchema) MinLength(length uint) SliceSchema {
-	sliceSchema.rules = append(sliceSchema.rules, func(value []any) error {
-		if len(value) < int(length) {
-			return RuleError{
-				Name:   RuleMinLength,
-				Value:  value,
-				Params: []any{length},
-			}
-		}
-		return nil
-	})
+	sliceSchema.lengths = append(slices.Clip(sliceSchema.lengths), minLengthRule(length))
 
 	return sliceSchema
 }
@@ -54,16 +64,32 @@ func (sliceSchema SliceSchema) MinLength(length uint) SliceSchema {
 // MaxLength validate the maximum length of a slice.
 // If the input is greater than the maximum length, it will return an error.
 func (sliceSchema SliceSchema) MaxLength(length uint) SliceSchema {
-	sliceSchema.rules = append(sliceSchema.rules, func(value []any) error {
-		if len(value) > int(length) {
-			return RuleError{
-				Name:   RuleMaxLength,
-				Value:  value,
-				Params: []any{length},
-			}
-		}
-		return nil
-	})
+	sliceSchema.lengths = append(slices.Clip(sliceSchema.lengths), maxLengthRule(length))
+
+	return sliceSchema
+}
+
+// NotLength validate that the length of a slice is not one of the lengths.
+// If the input length is equal to any of the lengths, it will return an error.
+func (sliceSchema SliceSchema) NotLength(lengths ...uint) SliceSchema {
+	if len(lengths) == 0 {
+		panic("slice lengths must not be empty")
+	}
+
+	sliceSchema.lengths = append(slices.Clip(sliceSchema.lengths), notLengthRule(lengths))
+
+	return sliceSchema
+}
+
+// Rule add a custom rule that validate the whole slice, e.g. that the elements are unique.
+// The rule receive a copy of the elements, which is only made when the schema has a rule.
+// If the rule return an error, it will return the error.
+func (sliceSchema SliceSchema) Rule(rule SliceRule) SliceSchema {
+	if rule == nil {
+		panic("slice rule must not be nil")
+	}
+
+	sliceSchema.rules = append(slices.Clip(sliceSchema.rules), rule)
 
 	return sliceSchema
 }
@@ -95,23 +121,50 @@ func (sliceSchema SliceSchema) Validate(value any) error {
 		}
 	}
 
-	sliceValue := make([]any, reflectedValue.Len())
+	for _, rule := range sliceSchema.lengths {
+		if err := rule(reflectedValue); err != nil {
+			return err
+		}
+	}
+
+	length := reflectedValue.Len()
 
-	i := 0
-	for _, element := range reflectedValue.Seq2() {
-		elementValue := element.Interface()
+	if sliceSchema.element != nil {
+		validateElement := func(i int) error {
+			element := reflectedValue.Index(i)
 
-		if err := sliceSchema.element.Validate(elementValue); err != nil {
-			return ElementError{
-				Index: i,
-				Value: element,
-				Err:   err,
+			if err := sliceSchema.element.Validate(element.Interface()); err != nil {
+				return ElementError{
+					Index: i,
+					Value: element,
+					Err:   err,
+				}
 			}
+
+			return nil
 		}
 
-		sliceValue[i] = elementValue
+		if sliceSchema.workers > 1 {
+			if err := validateConcurrently(length, sliceSchema.workers, validateElement); err != nil {
+				return err
+			}
+		} else {
+			for i := range length {
+				if err := validateElement(i); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if len(sliceSchema.rules) == 0 {
+		return nil
+	}
 
-		i++
+	// The elements are only copied when there is a rule that needs the whole slice.
+	sliceValue := make([]any, length)
+	for i := range sliceValue {
+		sliceValue[i] = reflectedValue.Index(i).Interface()
 	}
 
 	for _, rule := range sliceSchema.rules {
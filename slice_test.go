@@ -0,0 +1,56 @@
+package gosch
+
+import (
+	"errors"
+	"testing"
+)
+
+func BenchmarkSliceValidate(b *testing.B) {
+	value := make([]int, 1000)
+	for i := range value {
+		value[i] = i
+	}
+
+	benchmarks := []struct {
+		name   string
+		schema SliceSchema
+	}{
+		{name: "Length", schema: Slice().MinLength(1).MaxLength(1000)},
+		{name: "Element", schema: Slice().MinLength(1).Element(Int().MinValue(0))},
+		{name: "Rule", schema: Slice().Rule(func(value []any) error { return nil })},
+	}
+
+	for _, benchmark := range benchmarks {
+		b.Run(benchmark.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				if err := benchmark.schema.Validate(value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestSliceRule(t *testing.T) {
+	errDuplicate := errors.New("duplicate")
+
+	schema := Slice().Rule(func(value []any) error {
+		seen := map[any]bool{}
+		for _, element := range value {
+			if seen[element] {
+				return errDuplicate
+			}
+			seen[element] = true
+		}
+		return nil
+	})
+
+	if err := schema.Validate([]int{1, 2, 3}); err != nil {
+		t.Errorf("Validate([1 2 3]) = %v, want nil", err)
+	}
+	if err := schema.Validate([]int{1, 2, 1}); !errors.Is(err, errDuplicate) {
+		t.Errorf("Validate([1 2 1]) = %v, want %v", err, errDuplicate)
+	}
+}
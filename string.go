@@ -2,21 +2,49 @@ package gosch
 
 import (
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
 )
 
 type StringRule func(value string) error
 
+type stringValues struct {
+	values []string
+	fold   bool
+}
+
+func (stringValues stringValues) contains(value string) bool {
+	return slices.ContainsFunc(stringValues.values, func(allowed string) bool {
+		if stringValues.fold {
+			return strings.EqualFold(value, allowed)
+		}
+		return value == allowed
+	})
+}
+
 type StringSchema struct {
-	nilable bool
-	rules   []StringRule
+	nilable        bool
+	typ            reflect.Type
+	unit           LengthUnit
+	maxDecodedSize uint
+	encoded        bool
+	oneOfs         []stringValues
+	rules          []StringRule
 }
 
 // String validate data type of the input.
 // If the input is not a string, it will return an error.
 func String() StringSchema {
 	return StringSchema{
-		nilable: false,
-		rules:   []StringRule{},
+		nilable:        false,
+		typ:            nil,
+		unit:           UnitBytes,
+		maxDecodedSize: 0,
+		encoded:        false,
+		oneOfs:         []stringValues{},
+		rules:          []StringRule{},
 	}
 }
 
@@ -26,10 +54,29 @@ func (stringSchema StringSchema) Nil() StringSchema {
 	return stringSchema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the string kind.
+// It will panic if the kind of the type is not string.
+// If the input is of another type, it will return an error.
+func (stringSchema StringSchema) Type(exact reflect.Type) StringSchema {
+	if exact == nil || exact.Kind() != reflect.String {
+		panic("string type must be of kind string")
+	}
+
+	stringSchema.typ = exact
+	return stringSchema
+}
+
+// Unit set the unit used by the length rules that are added after it.
+// The default unit is UnitBytes.
+func (stringSchema StringSchema) Unit(unit LengthUnit) StringSchema {
+	stringSchema.unit = unit
+	return stringSchema
+}
+
 // NotEmpty validate that a string is not empty.
 // If the input is empty, it will return an error.
 func (stringSchema StringSchema) NotEmpty() StringSchema {
-	stringSchema.rules = append(stringSchema.rules, func(value string) error {
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
 		if value == "" {
 			return RuleError{
 				Name:  RuleNotEmpty,
@@ -42,15 +89,17 @@ func (stringSchema StringSchema) NotEmpty() StringSchema {
 	return stringSchema
 }
 
-// MinLength validate the minimum length of a string.
+// MinLength validate the minimum length of a string, measured in the schema unit.
 // If the input is less than the minimum length, it will return an error.
 func (stringSchema StringSchema) MinLength(length uint) StringSchema {
-	stringSchema.rules = append(stringSchema.rules, func(value string) error {
-		if len(value) < int(length) {
+	unit := stringSchema.unit
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if unit.length(value) < int(length) {
 			return RuleError{
 				Name:   RuleMinLength,
 				Value:  value,
-				Params: []any{length},
+				Params: []any{length, unit},
 			}
 		}
 		return nil
@@ -59,15 +108,131 @@ func (stringSchema StringSchema) MinLength(length uint) StringSchema {
 	return stringSchema
 }
 
-// MaxLength validate the maximum length of a string.
+// MaxLength validate the maximum length of a string, measured in the schema unit.
 // If the input is greater than the maximum length, it will return an error.
 func (stringSchema StringSchema) MaxLength(length uint) StringSchema {
-	stringSchema.rules = append(stringSchema.rules, func(value string) error {
-		if len(value) > int(length) {
+	unit := stringSchema.unit
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if unit.length(value) > int(length) {
 			return RuleError{
 				Name:   RuleMaxLength,
 				Value:  value,
-				Params: []any{length},
+				Params: []any{length, unit},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// NotLength validate that the length of a string, measured in the schema unit, is not one of the lengths.
+// If the input length is equal to any of the lengths, it will return an error.
+func (stringSchema StringSchema) NotLength(lengths ...uint) StringSchema {
+	if len(lengths) == 0 {
+		panic("string lengths must not be empty")
+	}
+
+	lengths = slices.Clone(lengths)
+	unit := stringSchema.unit
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if slices.Contains(lengths, uint(unit.length(value))) {
+			return RuleError{
+				Name:   RuleNotLength,
+				Value:  value,
+				Params: []any{lengths, unit},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// OneOf validate that a string is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (stringSchema StringSchema) OneOf(values ...string) StringSchema {
+	return stringSchema.oneOf(values, false)
+}
+
+// OneOfFold is the case-insensitive version of OneOf.
+func (stringSchema StringSchema) OneOfFold(values ...string) StringSchema {
+	return stringSchema.oneOf(values, true)
+}
+
+// Values return a copy of the values allowed by OneOf and OneOfFold, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (stringSchema StringSchema) Values() []string {
+	if len(stringSchema.oneOfs) == 0 {
+		return nil
+	}
+
+	values := slices.Clone(stringSchema.oneOfs[0].values)
+	for _, oneOf := range stringSchema.oneOfs[1:] {
+		values = slices.DeleteFunc(values, func(value string) bool {
+			return !oneOf.contains(value)
+		})
+	}
+
+	return values
+}
+
+func (stringSchema StringSchema) oneOf(values []string, fold bool) StringSchema {
+	if len(values) == 0 {
+		panic("string values must not be empty")
+	}
+
+	oneOf := stringValues{
+		values: slices.Clone(values),
+		fold:   fold,
+	}
+
+	stringSchema.oneOfs = append(slices.Clip(stringSchema.oneOfs), oneOf)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !oneOf.contains(value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{oneOf.values, fold},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// Pattern validate that a string match the regular expression.
+// If the input does not match the pattern, it will return an error.
+func (stringSchema StringSchema) Pattern(pattern *regexp.Regexp) StringSchema {
+	return stringSchema.NamedPattern("", pattern)
+}
+
+// PatternString compile the regular expression and validate that a string match it.
+// It will panic if the expression is invalid.
+// If the input does not match the pattern, it will return an error.
+func (stringSchema StringSchema) PatternString(expr string) StringSchema {
+	return stringSchema.NamedPattern("", regexp.MustCompile(expr))
+}
+
+// NamedPattern validate that a string match the regular expression.
+// The name is used in the error message instead of the expression, e.g. "value must be a valid zip code".
+// If the input does not match the pattern, it will return an error.
+func (stringSchema StringSchema) NamedPattern(name string, pattern *regexp.Regexp) StringSchema {
+	if pattern == nil {
+		panic("string pattern must not be nil")
+	}
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if !pattern.MatchString(value) {
+			return RuleError{
+				Name:   RulePattern,
+				Value:  value,
+				Params: []any{pattern.String(), name},
 			}
 		}
 		return nil
@@ -76,7 +241,135 @@ func (stringSchema StringSchema) MaxLength(length uint) StringSchema {
 	return stringSchema
 }
 
+// NotPattern validate that a string does not match the regular expression.
+// If the input match the pattern, it will return an error.
+func (stringSchema StringSchema) NotPattern(pattern *regexp.Regexp) StringSchema {
+	if pattern == nil {
+		panic("string pattern must not be nil")
+	}
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		if pattern.MatchString(value) {
+			return RuleError{
+				Name:   RuleNotPattern,
+				Value:  value,
+				Params: []any{pattern.String()},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// StartsWith validate that a string starts with one of the prefixes.
+// If the input does not start with any of the prefixes, it will return an error.
+func (stringSchema StringSchema) StartsWith(prefixes ...string) StringSchema {
+	return stringSchema.substring(RuleStartsWith, prefixes, false, strings.HasPrefix)
+}
+
+// StartsWithFold is the case-insensitive version of StartsWith.
+func (stringSchema StringSchema) StartsWithFold(prefixes ...string) StringSchema {
+	return stringSchema.substring(RuleStartsWith, prefixes, true, strings.HasPrefix)
+}
+
+// EndsWith validate that a string ends with one of the suffixes.
+// If the input does not end with any of the suffixes, it will return an error.
+func (stringSchema StringSchema) EndsWith(suffixes ...string) StringSchema {
+	return stringSchema.substring(RuleEndsWith, suffixes, false, strings.HasSuffix)
+}
+
+// EndsWithFold is the case-insensitive version of EndsWith.
+func (stringSchema StringSchema) EndsWithFold(suffixes ...string) StringSchema {
+	return stringSchema.substring(RuleEndsWith, suffixes, true, strings.HasSuffix)
+}
+
+// Includes validate that a string contains one of the substrings.
+// If the input does not contain any of the substrings, it will return an error.
+func (stringSchema StringSchema) Includes(substrings ...string) StringSchema {
+	return stringSchema.substring(RuleIncludes, substrings, false, strings.Contains)
+}
+
+// IncludesFold is the case-insensitive version of Includes.
+func (stringSchema StringSchema) IncludesFold(substrings ...string) StringSchema {
+	return stringSchema.substring(RuleIncludes, substrings, true, strings.Contains)
+}
+
+// Excludes validate that a string contains none of the substrings.
+// If the input contains any of the substrings, it will return an error.
+func (stringSchema StringSchema) Excludes(substrings ...string) StringSchema {
+	return stringSchema.substring(RuleExcludes, substrings, false, strings.Contains)
+}
+
+// ExcludesFold is the case-insensitive version of Excludes.
+func (stringSchema StringSchema) ExcludesFold(substrings ...string) StringSchema {
+	return stringSchema.substring(RuleExcludes, substrings, true, strings.Contains)
+}
+
+func (stringSchema StringSchema) substring(name RuleName, values []string, fold bool, match func(value string, substring string) bool) StringSchema {
+	if len(values) == 0 {
+		panic("string substrings must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	substrings := values
+	if fold {
+		substrings = make([]string, len(values))
+		for i, value := range values {
+			substrings[i] = foldCase(value)
+		}
+	}
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		matchValue := value
+		if fold {
+			matchValue = foldCase(value)
+		}
+
+		matched := slices.ContainsFunc(substrings, func(substring string) bool {
+			return match(matchValue, substring)
+		})
+
+		// Excludes is the only rule that fails on a match.
+		if matched == (name == RuleExcludes) {
+			return RuleError{
+				Name:   name,
+				Value:  value,
+				Params: []any{values, fold},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// foldCase map every rune to the smallest rune of its Unicode simple case folding orbit,
+// so two strings are equal after foldCase exactly when strings.EqualFold report them equal, e.g. "K" (Kelvin sign), "K" and "k".
+func foldCase(value string) string {
+	return strings.Map(func(r rune) rune {
+		folded := r
+		for next := unicode.SimpleFold(r); next != r; next = unicode.SimpleFold(next) {
+			folded = min(folded, next)
+		}
+		return folded
+	}, value)
+}
+
 func (stringSchema StringSchema) Validate(value any) error {
+	// The unnamed string and *string are the most common inputs, so they skip the reflection.
+	if stringSchema.typ == nil {
+		switch stringValue := value.(type) {
+		case string:
+			return stringSchema.validate(stringValue)
+		case *string:
+			if stringValue != nil {
+				return stringSchema.validate(*stringValue)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -103,10 +396,19 @@ func (stringSchema StringSchema) Validate(value any) error {
 		}
 	}
 
-	stringValue := reflectedValue.String()
+	if stringSchema.typ != nil && reflectedType != stringSchema.typ {
+		return TypeError{
+			Expected: stringSchema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return stringSchema.validate(reflectedValue.String())
+}
 
+func (stringSchema StringSchema) validate(value string) error {
 	for _, rule := range stringSchema.rules {
-		if err := rule(stringValue); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
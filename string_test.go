@@ -0,0 +1,106 @@
+package gosch
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFoldConsistency(t *testing.T) {
+	tests := []struct {
+		value   string
+		allowed string
+	}{
+		{value: "K", allowed: "k"},            // Kelvin sign
+		{value: "K", allowed: "K"},            // Kelvin sign
+		{value: "ς", allowed: "σ"},            // final sigma
+		{value: "ΣΑΣ", allowed: "σας"},        // capital and final sigma
+		{value: "ſ", allowed: "S"},            // long s
+		{value: "ı", allowed: "I"},            // dotless i is not folded to I
+		{value: "Straße", allowed: "STRASSE"}, // full case folding is not applied
+		{value: "Admin", allowed: "aDMIN"},
+	}
+
+	for _, test := range tests {
+		want := strings.EqualFold(test.value, test.allowed)
+
+		rules := map[string]StringSchema{
+			"OneOfFold":      String().OneOfFold(test.allowed),
+			"StartsWithFold": String().StartsWithFold(test.allowed),
+			"EndsWithFold":   String().EndsWithFold(test.allowed),
+			"IncludesFold":   String().IncludesFold(test.allowed),
+		}
+
+		for name, schema := range rules {
+			if got := schema.Validate(test.value) == nil; got != want {
+				t.Errorf("%s(%q).Validate(%q) passed = %v, want %v", name, test.allowed, test.value, got, want)
+			}
+		}
+
+		if got := String().ExcludesFold(test.allowed).Validate(test.value) != nil; got != want {
+			t.Errorf("ExcludesFold(%q).Validate(%q) failed = %v, want %v", test.allowed, test.value, got, want)
+		}
+	}
+}
+
+func TestStringValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema StringSchema
+		want   []string
+	}{
+		{name: "no OneOf", schema: String().NotEmpty(), want: nil},
+		{name: "OneOf", schema: String().OneOf("IDR", "USD", "EUR"), want: []string{"IDR", "USD", "EUR"}},
+		{name: "OneOfFold", schema: String().OneOfFold("admin", "member"), want: []string{"admin", "member"}},
+		{name: "intersection", schema: String().OneOf("IDR", "USD", "EUR").OneOf("USD", "EUR", "JPY"), want: []string{"USD", "EUR"}},
+		{name: "fold intersection", schema: String().OneOf("admin", "guest").OneOfFold("ADMIN"), want: []string{"admin"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.schema.Values(); !slices.Equal(got, test.want) {
+				t.Errorf("Values() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestStringValuesCopy(t *testing.T) {
+	schema := String().OneOf("a", "b")
+
+	values := schema.Values()
+	values[0] = "c"
+
+	if err := schema.Validate("a"); err != nil {
+		t.Errorf("Validate(%q) = %v, want nil", "a", err)
+	}
+	if err := schema.Validate("c"); err == nil {
+		t.Errorf("Validate(%q) = nil, want an error", "c")
+	}
+}
+
+type (
+	testUserID string
+	testKind   string
+)
+
+func TestStringType(t *testing.T) {
+	schema := String().Type(reflect.TypeFor[testUserID]())
+
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{value: testUserID("u1"), valid: true},
+		{value: "u1", valid: false},
+		{value: testKind("u1"), valid: false},
+	}
+
+	for _, test := range tests {
+		err := schema.Validate(test.value)
+		if got := err == nil; got != test.valid {
+			t.Errorf("Validate(%#v) = %v, want valid %v", test.value, err, test.valid)
+		}
+	}
+}
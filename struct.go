@@ -1,10 +1,20 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+	"sync"
+)
+
+type structField struct {
+	name   string
+	schema Schema
+}
 
 type StructSchema struct {
 	nilable bool
-	fields  map[string]Schema
+	fields  []structField
+	plans   *sync.Map
 }
 
 // Struct validate data type of the input.
@@ -12,7 +22,8 @@ type StructSchema struct {
 func Struct() StructSchema {
 	return StructSchema{
 		nilable: false,
-		fields:  map[string]Schema{},
+		fields:  []structField{},
+		plans:   &sync.Map{},
 	}
 }
 
@@ -25,8 +36,25 @@ func (structSchema StructSchema) Nil() StructSchema {
 // Field validate the field of a struct.
 // If the field is not in the struct, it will return an error.
 // If the field is not match the schema, it will return an error.
+// The fields are validated in the order they are declared,
+// redeclaring a field replace its schema but keep its position.
 func (structSchema StructSchema) Field(name string, schema Schema) StructSchema {
-	structSchema.fields[name] = schema
+	index := slices.IndexFunc(structSchema.fields, func(field structField) bool {
+		return field.name == name
+	})
+
+	if index >= 0 {
+		structSchema.fields = slices.Clone(structSchema.fields)
+		structSchema.fields[index].schema = schema
+	} else {
+		structSchema.fields = append(slices.Clip(structSchema.fields), structField{
+			name:   name,
+			schema: schema,
+		})
+	}
+
+	// The cached plans belong to the previous fields.
+	structSchema.plans = &sync.Map{}
 
 	return structSchema
 }
@@ -58,20 +86,26 @@ func (structSchema StructSchema) Validate(value any) error {
 		}
 	}
 
-	for fieldName, fieldSchema := range structSchema.fields {
-		fieldValue := reflectedValue.FieldByName(fieldName)
+	indexes := structSchema.plan(reflectedType)
+
+	for i, field := range structSchema.fields {
+		fieldValue := reflect.Value{}
+		if indexes[i] != nil {
+			// An error here means the field is promoted through a nil embedded pointer.
+			fieldValue, _ = reflectedValue.FieldByIndexErr(indexes[i])
+		}
 
 		if !fieldValue.IsValid() {
 			return RuleError{
 				Name:   RuleField,
 				Value:  fieldValue,
-				Params: []any{fieldName},
+				Params: []any{field.name},
 			}
 		}
 
-		if err := fieldSchema.Validate(fieldValue.Interface()); err != nil {
+		if err := field.schema.Validate(fieldValue.Interface()); err != nil {
 			return FieldError{
-				Name:  fieldName,
+				Name:  field.name,
 				Value: fieldValue,
 				Err:   err,
 			}
@@ -80,3 +114,27 @@ func (structSchema StructSchema) Validate(value any) error {
 
 	return nil
 }
+
+// plan return the index of every field in the given struct type, in the same order as the fields.
+// A missing field has a nil index.
+// The result is cached per type on first use, so only the first validation of a type look up the fields by name.
+func (structSchema StructSchema) plan(reflectedType reflect.Type) [][]int {
+	if structSchema.plans != nil {
+		if indexes, ok := structSchema.plans.Load(reflectedType); ok {
+			return indexes.([][]int)
+		}
+	}
+
+	indexes := make([][]int, len(structSchema.fields))
+	for i, field := range structSchema.fields {
+		if structField, ok := reflectedType.FieldByName(field.name); ok {
+			indexes[i] = structField.Index
+		}
+	}
+
+	if structSchema.plans != nil {
+		structSchema.plans.Store(reflectedType, indexes)
+	}
+
+	return indexes
+}
@@ -0,0 +1,53 @@
+package gosch
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+type TextSchema struct {
+	schema Schema
+}
+
+// Text validate the textual form of the input with the schema.
+// An input that implement encoding.TextMarshaler is validated through MarshalText, otherwise one that implement fmt.Stringer is validated through String.
+// A nil pointer is passed to the schema as nil and any other input is passed unchanged.
+func Text(schema Schema) TextSchema {
+	return TextSchema{
+		schema: schema,
+	}
+}
+
+func (textSchema TextSchema) Validate(value any) error {
+	if isNil(value) {
+		return textSchema.schema.Validate(nil)
+	}
+
+	switch textValue := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := textValue.MarshalText()
+		if err != nil {
+			return RuleError{
+				Name:   RuleConvert,
+				Value:  value,
+				Params: []any{err},
+			}
+		}
+		return textSchema.schema.Validate(string(text))
+	case fmt.Stringer:
+		return textSchema.schema.Validate(textValue.String())
+	default:
+		return textSchema.schema.Validate(value)
+	}
+}
+
+// isNil report whether the input is nil or a nil pointer, whose methods may not be safe to call.
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	return reflectedValue.Kind() == reflect.Ptr && reflectedValue.IsNil()
+}
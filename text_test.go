@@ -0,0 +1,67 @@
+package gosch
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+type testBoth struct{}
+
+func (testBoth) MarshalText() ([]byte, error) { return []byte("text"), nil }
+func (testBoth) String() string               { return "string" }
+
+type testStringer struct{}
+
+func (testStringer) String() string { return "string" }
+
+type testFailing struct{}
+
+func (testFailing) MarshalText() ([]byte, error) { return nil, errors.New("marshal failed") }
+
+type testPointerText struct{ text string }
+
+func (text *testPointerText) MarshalText() ([]byte, error) { return []byte(text.text), nil }
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		valid  bool
+	}{
+		{name: "TextMarshaler", schema: Text(String().IP()), value: netip.MustParseAddr("192.0.2.1"), valid: true},
+		{name: "TextMarshaler before Stringer", schema: Text(String().OneOf("text")), value: testBoth{}, valid: true},
+		{name: "Stringer ignored when TextMarshaler", schema: Text(String().OneOf("string")), value: testBoth{}, valid: false},
+		{name: "Stringer", schema: Text(String().OneOf("string")), value: testStringer{}, valid: true},
+		{name: "Stringer Duration", schema: Text(String().OneOf("1m30s")), value: 90 * time.Second, valid: true},
+		{name: "plain string", schema: Text(String().OneOf("plain")), value: "plain", valid: true},
+		{name: "plain int", schema: Text(String()), value: 1, valid: false},
+		{name: "pointer receiver", schema: Text(String().OneOf("pointer")), value: &testPointerText{text: "pointer"}, valid: true},
+		{name: "nil pointer", schema: Text(String()), value: (*testPointerText)(nil), valid: false},
+		{name: "nil pointer with Nil", schema: Text(String().Nil()), value: (*testPointerText)(nil), valid: true},
+		{name: "nil", schema: Text(String().Nil()), value: nil, valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestTextConvertError(t *testing.T) {
+	err := Text(String()).Validate(testFailing{})
+
+	ruleError, ok := err.(RuleError)
+	if !ok || ruleError.Name != RuleConvert {
+		t.Fatalf("Validate(testFailing{}) = %v, want a %v error", err, RuleConvert)
+	}
+	if cause, ok := ruleError.Params[0].(error); !ok || cause.Error() != "marshal failed" {
+		t.Errorf("Validate(testFailing{}) params = %v, want the MarshalText error", ruleError.Params)
+	}
+}
@@ -0,0 +1,202 @@
+package gosch
+
+import (
+	"slices"
+	"time"
+)
+
+type TimeRule func(value time.Time) error
+
+type TimeSchema struct {
+	nilable bool
+	coerce  bool
+	rules   []TimeRule
+}
+
+// Time validate data type of the input.
+// If the input is not a time.Time, it will return an error.
+func Time() TimeSchema {
+	return TimeSchema{
+		nilable: false,
+		coerce:  false,
+		rules:   []TimeRule{},
+	}
+}
+
+// Nil will pass nil input.
+func (timeSchema TimeSchema) Nil() TimeSchema {
+	timeSchema.nilable = true
+	return timeSchema
+}
+
+// Coerce will also accept an RFC 3339 string and validate the parsed time.
+func (timeSchema TimeSchema) Coerce() TimeSchema {
+	timeSchema.coerce = true
+	return timeSchema
+}
+
+// Before validate that a time is before the instant.
+// If the input is equal to or after the instant, it will return an error.
+func (timeSchema TimeSchema) Before(instant time.Time) TimeSchema {
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if !value.Before(instant) {
+			return RuleError{
+				Name:   RuleBefore,
+				Value:  value,
+				Params: []any{instant},
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+// After validate that a time is after the instant.
+// If the input is equal to or before the instant, it will return an error.
+func (timeSchema TimeSchema) After(instant time.Time) TimeSchema {
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if !value.After(instant) {
+			return RuleError{
+				Name:   RuleAfter,
+				Value:  value,
+				Params: []any{instant},
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+// Between validate that a time is between the minimum and maximum instant, both inclusive.
+// It will panic if the minimum instant is after the maximum instant.
+// If the input is out of the range, it will return an error.
+func (timeSchema TimeSchema) Between(min time.Time, max time.Time) TimeSchema {
+	if min.After(max) {
+		panic("time minimum instant must be before or equal to the maximum instant")
+	}
+
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if value.Before(min) || value.After(max) {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+// NotZero validate that a time is not the zero time.
+// If the input is the zero time, it will return an error.
+func (timeSchema TimeSchema) NotZero() TimeSchema {
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if value.IsZero() {
+			return RuleError{
+				Name:  RuleNotZero,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+// Weekday validate that a time is on one of the days, in its own location.
+// If the input is on another day, it will return an error.
+func (timeSchema TimeSchema) Weekday(days ...time.Weekday) TimeSchema {
+	if len(days) == 0 {
+		panic("time days must not be empty")
+	}
+
+	days = slices.Clone(days)
+
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if !slices.Contains(days, value.Weekday()) {
+			return RuleError{
+				Name:   RuleWeekday,
+				Value:  value,
+				Params: []any{days},
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+// Location validate that the location of a time is one of the locations, compared by name.
+// If the input is in another location, it will return an error.
+func (timeSchema TimeSchema) Location(locations ...*time.Location) TimeSchema {
+	if len(locations) == 0 {
+		panic("time locations must not be empty")
+	}
+
+	names := make([]string, len(locations))
+	for i, location := range locations {
+		names[i] = location.String()
+	}
+
+	timeSchema.rules = append(slices.Clip(timeSchema.rules), func(value time.Time) error {
+		if !slices.Contains(names, value.Location().String()) {
+			return RuleError{
+				Name:   RuleLocation,
+				Value:  value,
+				Params: []any{names},
+			}
+		}
+		return nil
+	})
+
+	return timeSchema
+}
+
+func (timeSchema TimeSchema) Validate(value any) error {
+	if timeSchema.coerce {
+		switch stringValue := value.(type) {
+		case string:
+			return timeSchema.validateString(stringValue)
+		case *string:
+			if stringValue != nil {
+				return timeSchema.validateString(*stringValue)
+			}
+		}
+	}
+
+	timeValue, ok, err := typed[time.Time](value)
+	if err != nil || !ok {
+		if !ok && timeSchema.nilable {
+			return nil
+		}
+		return err
+	}
+
+	return timeSchema.validate(timeValue)
+}
+
+func (timeSchema TimeSchema) validateString(value string) error {
+	timeValue, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return RuleError{
+			Name:  RuleDateTime,
+			Value: value,
+		}
+	}
+
+	return timeSchema.validate(timeValue)
+}
+
+func (timeSchema TimeSchema) validate(value time.Time) error {
+	for _, rule := range timeSchema.rules {
+		if err := rule(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
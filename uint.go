@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type UintRule func(value uint) error
 
 type UintSchema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]uint
 	rules   []UintRule
 }
 
@@ -14,6 +19,8 @@ type UintSchema struct {
 func Uint() UintSchema {
 	return UintSchema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]uint{},
 		rules:   []UintRule{},
 	}
 }
@@ -24,10 +31,22 @@ func (uintSchema UintSchema) Nil() UintSchema {
 	return uintSchema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the uint kind.
+// It will panic if the kind of the type is not uint.
+// If the input is of another type, it will return an error.
+func (uintSchema UintSchema) Type(exact reflect.Type) UintSchema {
+	if exact == nil || exact.Kind() != reflect.Uint {
+		panic("uint type must be of kind uint")
+	}
+
+	uintSchema.typ = exact
+	return uintSchema
+}
+
 // MinValue validate the minimum value of an uint.
 // If the input is less than the minimum value, it will return an error.
 func (uintSchema UintSchema) MinValue(min uint) UintSchema {
-	uintSchema.rules = append(uintSchema.rules, func(value uint) error {
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (uintSchema UintSchema) MinValue(min uint) UintSchema {
 // MaxValue validate the maximum value of an uint.
 // If the input is greater than the maximum value, it will return an error.
 func (uintSchema UintSchema) MaxValue(max uint) UintSchema {
-	uintSchema.rules = append(uintSchema.rules, func(value uint) error {
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,175 @@ func (uintSchema UintSchema) MaxValue(max uint) UintSchema {
 	return uintSchema
 }
 
+// MultipleOf validate that an uint is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (uintSchema UintSchema) MultipleOf(n uint) UintSchema {
+	if n == 0 {
+		panic("uint multiple must not be 0")
+	}
+
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// Positive validate that an uint is greater than 0.
+// If the input is 0, it will return an error.
+func (uintSchema UintSchema) Positive() UintSchema {
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if value == 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// NonNegative validate that an uint is greater than or equal to 0.
+// Every uint is non-negative, so it does not add a rule.
+// There is no Negative rule, since it could only always fail.
+func (uintSchema UintSchema) NonNegative() UintSchema {
+	return uintSchema
+}
+
+// GreaterThan validate the exclusive minimum value of an uint.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (uintSchema UintSchema) GreaterThan(min uint) UintSchema {
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// LessThan validate the exclusive maximum value of an uint.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (uintSchema UintSchema) LessThan(max uint) UintSchema {
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// Between validate that an uint is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (uintSchema UintSchema) Between(min uint, max uint) UintSchema {
+	if min > max {
+		panic("uint minimum value must be less than or equal to the maximum value")
+	}
+
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// OneOf validate that an uint is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (uintSchema UintSchema) OneOf(values ...uint) UintSchema {
+	if len(values) == 0 {
+		panic("uint values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uintSchema.oneOfs = append(slices.Clip(uintSchema.oneOfs), values)
+
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (uintSchema UintSchema) Values() []uint {
+	return intersectValues(uintSchema.oneOfs)
+}
+
+// NotValue validate that an uint is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (uintSchema UintSchema) NotValue(values ...uint) UintSchema {
+	if len(values) == 0 {
+		panic("uint values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uintSchema.rules = append(slices.Clip(uintSchema.rules), func(value uint) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uintSchema
+}
+
 func (uintSchema UintSchema) Validate(value any) error {
+	// The unnamed uint and *uint are the most common inputs, so they skip the reflection.
+	if uintSchema.typ == nil {
+		switch uintValue := value.(type) {
+		case uint:
+			return uintSchema.validate(uintValue)
+		case *uint:
+			if uintValue != nil {
+				return uintSchema.validate(*uintValue)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +272,19 @@ func (uintSchema UintSchema) Validate(value any) error {
 		}
 	}
 
-	uintValue := uint(reflectedValue.Uint())
+	if uintSchema.typ != nil && reflectedType != uintSchema.typ {
+		return TypeError{
+			Expected: uintSchema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return uintSchema.validate(uint(reflectedValue.Uint()))
+}
 
+func (uintSchema UintSchema) validate(value uint) error {
 	for _, rule := range uintSchema.rules {
-		if err := rule(uintValue); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Uint16Rule func(value uint16) error
 
 type Uint16Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]uint16
 	rules   []Uint16Rule
 }
 
@@ -14,6 +19,8 @@ type Uint16Schema struct {
 func Uint16() Uint16Schema {
 	return Uint16Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]uint16{},
 		rules:   []Uint16Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (uint16Schema Uint16Schema) Nil() Uint16Schema {
 	return uint16Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the uint16 kind.
+// It will panic if the kind of the type is not uint16.
+// If the input is of another type, it will return an error.
+func (uint16Schema Uint16Schema) Type(exact reflect.Type) Uint16Schema {
+	if exact == nil || exact.Kind() != reflect.Uint16 {
+		panic("uint16 type must be of kind uint16")
+	}
+
+	uint16Schema.typ = exact
+	return uint16Schema
+}
+
 // MinValue validate the minimum value of an uint16.
 // If the input is less than the minimum value, it will return an error.
 func (uint16Schema Uint16Schema) MinValue(min uint16) Uint16Schema {
-	uint16Schema.rules = append(uint16Schema.rules, func(value uint16) error {
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (uint16Schema Uint16Schema) MinValue(min uint16) Uint16Schema {
 // MaxValue validate the maximum value of an uint16.
 // If the input is greater than the maximum value, it will return an error.
 func (uint16Schema Uint16Schema) MaxValue(max uint16) Uint16Schema {
-	uint16Schema.rules = append(uint16Schema.rules, func(value uint16) error {
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,175 @@ func (uint16Schema Uint16Schema) MaxValue(max uint16) Uint16Schema {
 	return uint16Schema
 }
 
+// MultipleOf validate that an uint16 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (uint16Schema Uint16Schema) MultipleOf(n uint16) Uint16Schema {
+	if n == 0 {
+		panic("uint16 multiple must not be 0")
+	}
+
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// Positive validate that an uint16 is greater than 0.
+// If the input is 0, it will return an error.
+func (uint16Schema Uint16Schema) Positive() Uint16Schema {
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if value == 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// NonNegative validate that an uint16 is greater than or equal to 0.
+// Every uint16 is non-negative, so it does not add a rule.
+// There is no Negative rule, since it could only always fail.
+func (uint16Schema Uint16Schema) NonNegative() Uint16Schema {
+	return uint16Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an uint16.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (uint16Schema Uint16Schema) GreaterThan(min uint16) Uint16Schema {
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// LessThan validate the exclusive maximum value of an uint16.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (uint16Schema Uint16Schema) LessThan(max uint16) Uint16Schema {
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// Between validate that an uint16 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (uint16Schema Uint16Schema) Between(min uint16, max uint16) Uint16Schema {
+	if min > max {
+		panic("uint16 minimum value must be less than or equal to the maximum value")
+	}
+
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// OneOf validate that an uint16 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (uint16Schema Uint16Schema) OneOf(values ...uint16) Uint16Schema {
+	if len(values) == 0 {
+		panic("uint16 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint16Schema.oneOfs = append(slices.Clip(uint16Schema.oneOfs), values)
+
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (uint16Schema Uint16Schema) Values() []uint16 {
+	return intersectValues(uint16Schema.oneOfs)
+}
+
+// NotValue validate that an uint16 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (uint16Schema Uint16Schema) NotValue(values ...uint16) Uint16Schema {
+	if len(values) == 0 {
+		panic("uint16 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint16Schema.rules = append(slices.Clip(uint16Schema.rules), func(value uint16) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint16Schema
+}
+
 func (uint16Schema Uint16Schema) Validate(value any) error {
+	// The unnamed uint16 and *uint16 are the most common inputs, so they skip the reflection.
+	if uint16Schema.typ == nil {
+		switch uint16Value := value.(type) {
+		case uint16:
+			return uint16Schema.validate(uint16Value)
+		case *uint16:
+			if uint16Value != nil {
+				return uint16Schema.validate(*uint16Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +272,19 @@ func (uint16Schema Uint16Schema) Validate(value any) error {
 		}
 	}
 
-	uint16Value := uint16(reflectedValue.Uint())
+	if uint16Schema.typ != nil && reflectedType != uint16Schema.typ {
+		return TypeError{
+			Expected: uint16Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return uint16Schema.validate(uint16(reflectedValue.Uint()))
+}
 
+func (uint16Schema Uint16Schema) validate(value uint16) error {
 	for _, rule := range uint16Schema.rules {
-		if err := rule(uint16Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
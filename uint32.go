@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Uint32Rule func(value uint32) error
 
 type Uint32Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]uint32
 	rules   []Uint32Rule
 }
 
@@ -14,6 +19,8 @@ type Uint32Schema struct {
 func Uint32() Uint32Schema {
 	return Uint32Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]uint32{},
 		rules:   []Uint32Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (uint32Schema Uint32Schema) Nil() Uint32Schema {
 	return uint32Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the uint32 kind.
+// It will panic if the kind of the type is not uint32.
+// If the input is of another type, it will return an error.
+func (uint32Schema Uint32Schema) Type(exact reflect.Type) Uint32Schema {
+	if exact == nil || exact.Kind() != reflect.Uint32 {
+		panic("uint32 type must be of kind uint32")
+	}
+
+	uint32Schema.typ = exact
+	return uint32Schema
+}
+
 // MinValue validate the minimum value of an uint32.
 // If the input is less than the minimum value, it will return an error.
 func (uint32Schema Uint32Schema) MinValue(min uint32) Uint32Schema {
-	uint32Schema.rules = append(uint32Schema.rules, func(value uint32) error {
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (uint32Schema Uint32Schema) MinValue(min uint32) Uint32Schema {
 // MaxValue validate the maximum value of an uint32.
 // If the input is greater than the maximum value, it will return an error.
 func (uint32Schema Uint32Schema) MaxValue(max uint32) Uint32Schema {
-	uint32Schema.rules = append(uint32Schema.rules, func(value uint32) error {
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,175 @@ func (uint32Schema Uint32Schema) MaxValue(max uint32) Uint32Schema {
 	return uint32Schema
 }
 
+// MultipleOf validate that an uint32 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (uint32Schema Uint32Schema) MultipleOf(n uint32) Uint32Schema {
+	if n == 0 {
+		panic("uint32 multiple must not be 0")
+	}
+
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// Positive validate that an uint32 is greater than 0.
+// If the input is 0, it will return an error.
+func (uint32Schema Uint32Schema) Positive() Uint32Schema {
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if value == 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// NonNegative validate that an uint32 is greater than or equal to 0.
+// Every uint32 is non-negative, so it does not add a rule.
+// There is no Negative rule, since it could only always fail.
+func (uint32Schema Uint32Schema) NonNegative() Uint32Schema {
+	return uint32Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an uint32.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (uint32Schema Uint32Schema) GreaterThan(min uint32) Uint32Schema {
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// LessThan validate the exclusive maximum value of an uint32.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (uint32Schema Uint32Schema) LessThan(max uint32) Uint32Schema {
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// Between validate that an uint32 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (uint32Schema Uint32Schema) Between(min uint32, max uint32) Uint32Schema {
+	if min > max {
+		panic("uint32 minimum value must be less than or equal to the maximum value")
+	}
+
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// OneOf validate that an uint32 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (uint32Schema Uint32Schema) OneOf(values ...uint32) Uint32Schema {
+	if len(values) == 0 {
+		panic("uint32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint32Schema.oneOfs = append(slices.Clip(uint32Schema.oneOfs), values)
+
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (uint32Schema Uint32Schema) Values() []uint32 {
+	return intersectValues(uint32Schema.oneOfs)
+}
+
+// NotValue validate that an uint32 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (uint32Schema Uint32Schema) NotValue(values ...uint32) Uint32Schema {
+	if len(values) == 0 {
+		panic("uint32 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint32Schema.rules = append(slices.Clip(uint32Schema.rules), func(value uint32) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint32Schema
+}
+
 func (uint32Schema Uint32Schema) Validate(value any) error {
+	// The unnamed uint32 and *uint32 are the most common inputs, so they skip the reflection.
+	if uint32Schema.typ == nil {
+		switch uint32Value := value.(type) {
+		case uint32:
+			return uint32Schema.validate(uint32Value)
+		case *uint32:
+			if uint32Value != nil {
+				return uint32Schema.validate(*uint32Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +272,19 @@ func (uint32Schema Uint32Schema) Validate(value any) error {
 		}
 	}
 
-	uint32Value := uint32(reflectedValue.Uint())
+	if uint32Schema.typ != nil && reflectedType != uint32Schema.typ {
+		return TypeError{
+			Expected: uint32Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return uint32Schema.validate(uint32(reflectedValue.Uint()))
+}
 
+func (uint32Schema Uint32Schema) validate(value uint32) error {
 	for _, rule := range uint32Schema.rules {
-		if err := rule(uint32Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Uint64Rule func(value uint64) error
 
 type Uint64Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]uint64
 	rules   []Uint64Rule
 }
 
@@ -14,6 +19,8 @@ type Uint64Schema struct {
 func Uint64() Uint64Schema {
 	return Uint64Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]uint64{},
 		rules:   []Uint64Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (uint64Schema Uint64Schema) Nil() Uint64Schema {
 	return uint64Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the uint64 kind.
+// It will panic if the kind of the type is not uint64.
+// If the input is of another type, it will return an error.
+func (uint64Schema Uint64Schema) Type(exact reflect.Type) Uint64Schema {
+	if exact == nil || exact.Kind() != reflect.Uint64 {
+		panic("uint64 type must be of kind uint64")
+	}
+
+	uint64Schema.typ = exact
+	return uint64Schema
+}
+
 // MinValue validate the minimum value of an uint64.
 // If the input is less than the minimum value, it will return an error.
 func (uint64Schema Uint64Schema) MinValue(min uint64) Uint64Schema {
-	uint64Schema.rules = append(uint64Schema.rules, func(value uint64) error {
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (uint64Schema Uint64Schema) MinValue(min uint64) Uint64Schema {
 // MaxValue validate the maximum value of an uint64.
 // If the input is greater than the maximum value, it will return an error.
 func (uint64Schema Uint64Schema) MaxValue(max uint64) Uint64Schema {
-	uint64Schema.rules = append(uint64Schema.rules, func(value uint64) error {
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,175 @@ func (uint64Schema Uint64Schema) MaxValue(max uint64) Uint64Schema {
 	return uint64Schema
 }
 
+// MultipleOf validate that an uint64 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (uint64Schema Uint64Schema) MultipleOf(n uint64) Uint64Schema {
+	if n == 0 {
+		panic("uint64 multiple must not be 0")
+	}
+
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// Positive validate that an uint64 is greater than 0.
+// If the input is 0, it will return an error.
+func (uint64Schema Uint64Schema) Positive() Uint64Schema {
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if value == 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// NonNegative validate that an uint64 is greater than or equal to 0.
+// Every uint64 is non-negative, so it does not add a rule.
+// There is no Negative rule, since it could only always fail.
+func (uint64Schema Uint64Schema) NonNegative() Uint64Schema {
+	return uint64Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an uint64.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (uint64Schema Uint64Schema) GreaterThan(min uint64) Uint64Schema {
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// LessThan validate the exclusive maximum value of an uint64.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (uint64Schema Uint64Schema) LessThan(max uint64) Uint64Schema {
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// Between validate that an uint64 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (uint64Schema Uint64Schema) Between(min uint64, max uint64) Uint64Schema {
+	if min > max {
+		panic("uint64 minimum value must be less than or equal to the maximum value")
+	}
+
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// OneOf validate that an uint64 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (uint64Schema Uint64Schema) OneOf(values ...uint64) Uint64Schema {
+	if len(values) == 0 {
+		panic("uint64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint64Schema.oneOfs = append(slices.Clip(uint64Schema.oneOfs), values)
+
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (uint64Schema Uint64Schema) Values() []uint64 {
+	return intersectValues(uint64Schema.oneOfs)
+}
+
+// NotValue validate that an uint64 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (uint64Schema Uint64Schema) NotValue(values ...uint64) Uint64Schema {
+	if len(values) == 0 {
+		panic("uint64 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint64Schema.rules = append(slices.Clip(uint64Schema.rules), func(value uint64) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint64Schema
+}
+
 func (uint64Schema Uint64Schema) Validate(value any) error {
+	// The unnamed uint64 and *uint64 are the most common inputs, so they skip the reflection.
+	if uint64Schema.typ == nil {
+		switch uint64Value := value.(type) {
+		case uint64:
+			return uint64Schema.validate(uint64Value)
+		case *uint64:
+			if uint64Value != nil {
+				return uint64Schema.validate(*uint64Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +272,19 @@ func (uint64Schema Uint64Schema) Validate(value any) error {
 		}
 	}
 
-	uint64Value := uint64(reflectedValue.Uint())
+	if uint64Schema.typ != nil && reflectedType != uint64Schema.typ {
+		return TypeError{
+			Expected: uint64Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return uint64Schema.validate(uint64(reflectedValue.Uint()))
+}
 
+func (uint64Schema Uint64Schema) validate(value uint64) error {
 	for _, rule := range uint64Schema.rules {
-		if err := rule(uint64Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
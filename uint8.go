@@ -1,11 +1,16 @@
 package gosch
 
-import "reflect"
+import (
+	"reflect"
+	"slices"
+)
 
 type Uint8Rule func(value uint8) error
 
 type Uint8Schema struct {
 	nilable bool
+	typ     reflect.Type
+	oneOfs  [][]uint8
 	rules   []Uint8Rule
 }
 
@@ -14,6 +19,8 @@ type Uint8Schema struct {
 func Uint8() Uint8Schema {
 	return Uint8Schema{
 		nilable: false,
+		typ:     nil,
+		oneOfs:  [][]uint8{},
 		rules:   []Uint8Rule{},
 	}
 }
@@ -24,10 +31,22 @@ func (uint8Schema Uint8Schema) Nil() Uint8Schema {
 	return uint8Schema
 }
 
+// Type validate that the input is exactly of the type, e.g. reflect.TypeFor[UserID](), instead of any type with the uint8 kind.
+// It will panic if the kind of the type is not uint8.
+// If the input is of another type, it will return an error.
+func (uint8Schema Uint8Schema) Type(exact reflect.Type) Uint8Schema {
+	if exact == nil || exact.Kind() != reflect.Uint8 {
+		panic("uint8 type must be of kind uint8")
+	}
+
+	uint8Schema.typ = exact
+	return uint8Schema
+}
+
 // MinValue validate the minimum value of an uint8.
 // If the input is less than the minimum value, it will return an error.
 func (uint8Schema Uint8Schema) MinValue(min uint8) Uint8Schema {
-	uint8Schema.rules = append(uint8Schema.rules, func(value uint8) error {
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
 		if value < min {
 			return RuleError{
 				Name:   RuleMinValue,
@@ -44,7 +63,7 @@ func (uint8Schema Uint8Schema) MinValue(min uint8) Uint8Schema {
 // MaxValue validate the maximum value of an uint8.
 // If the input is greater than the maximum value, it will return an error.
 func (uint8Schema Uint8Schema) MaxValue(max uint8) Uint8Schema {
-	uint8Schema.rules = append(uint8Schema.rules, func(value uint8) error {
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
 		if value > max {
 			return RuleError{
 				Name:   RuleMaxValue,
@@ -58,7 +77,175 @@ func (uint8Schema Uint8Schema) MaxValue(max uint8) Uint8Schema {
 	return uint8Schema
 }
 
+// MultipleOf validate that an uint8 is a multiple of n.
+// It will panic if n is 0.
+// If the input is not a multiple of n, it will return an error.
+func (uint8Schema Uint8Schema) MultipleOf(n uint8) Uint8Schema {
+	if n == 0 {
+		panic("uint8 multiple must not be 0")
+	}
+
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if value%n != 0 {
+			return RuleError{
+				Name:   RuleMultipleOf,
+				Value:  value,
+				Params: []any{n},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// Positive validate that an uint8 is greater than 0.
+// If the input is 0, it will return an error.
+func (uint8Schema Uint8Schema) Positive() Uint8Schema {
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if value == 0 {
+			return RuleError{
+				Name:  RulePositive,
+				Value: value,
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// NonNegative validate that an uint8 is greater than or equal to 0.
+// Every uint8 is non-negative, so it does not add a rule.
+// There is no Negative rule, since it could only always fail.
+func (uint8Schema Uint8Schema) NonNegative() Uint8Schema {
+	return uint8Schema
+}
+
+// GreaterThan validate the exclusive minimum value of an uint8.
+// If the input is less than or equal to the minimum value, it will return an error.
+func (uint8Schema Uint8Schema) GreaterThan(min uint8) Uint8Schema {
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if value <= min {
+			return RuleError{
+				Name:   RuleGreaterThan,
+				Value:  value,
+				Params: []any{min},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// LessThan validate the exclusive maximum value of an uint8.
+// If the input is greater than or equal to the maximum value, it will return an error.
+func (uint8Schema Uint8Schema) LessThan(max uint8) Uint8Schema {
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if value >= max {
+			return RuleError{
+				Name:   RuleLessThan,
+				Value:  value,
+				Params: []any{max},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// Between validate that an uint8 is between the minimum and maximum value, both inclusive.
+// It will panic if the minimum value is greater than the maximum value.
+// If the input is out of the range, it will return an error.
+func (uint8Schema Uint8Schema) Between(min uint8, max uint8) Uint8Schema {
+	if min > max {
+		panic("uint8 minimum value must be less than or equal to the maximum value")
+	}
+
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if value < min || value > max {
+			return RuleError{
+				Name:   RuleBetween,
+				Value:  value,
+				Params: []any{min, max},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// OneOf validate that an uint8 is one of the values.
+// If the input is not equal to any of the values, it will return an error.
+func (uint8Schema Uint8Schema) OneOf(values ...uint8) Uint8Schema {
+	if len(values) == 0 {
+		panic("uint8 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint8Schema.oneOfs = append(slices.Clip(uint8Schema.oneOfs), values)
+
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if !slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleOneOf,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
+// Values return a copy of the values allowed by OneOf, e.g. to document them or to generate a JSON Schema.
+// If there are several of them, only the values that pass all of them are returned.
+// If the schema has no OneOf rule, it will return nil.
+func (uint8Schema Uint8Schema) Values() []uint8 {
+	return intersectValues(uint8Schema.oneOfs)
+}
+
+// NotValue validate that an uint8 is not one of the values.
+// If the input is equal to any of the values, it will return an error.
+func (uint8Schema Uint8Schema) NotValue(values ...uint8) Uint8Schema {
+	if len(values) == 0 {
+		panic("uint8 values must not be empty")
+	}
+
+	values = slices.Clone(values)
+
+	uint8Schema.rules = append(slices.Clip(uint8Schema.rules), func(value uint8) error {
+		if slices.Contains(values, value) {
+			return RuleError{
+				Name:   RuleNotValue,
+				Value:  value,
+				Params: []any{values},
+			}
+		}
+		return nil
+	})
+
+	return uint8Schema
+}
+
 func (uint8Schema Uint8Schema) Validate(value any) error {
+	// The unnamed uint8 and *uint8 are the most common inputs, so they skip the reflection.
+	if uint8Schema.typ == nil {
+		switch uint8Value := value.(type) {
+		case uint8:
+			return uint8Schema.validate(uint8Value)
+		case *uint8:
+			if uint8Value != nil {
+				return uint8Schema.validate(*uint8Value)
+			}
+		}
+	}
+
 	reflectedValue := reflect.ValueOf(value)
 	reflectedType := reflect.TypeOf(value)
 
@@ -85,10 +272,19 @@ func (uint8Schema Uint8Schema) Validate(value any) error {
 		}
 	}
 
-	uint8Value := uint8(reflectedValue.Uint())
+	if uint8Schema.typ != nil && reflectedType != uint8Schema.typ {
+		return TypeError{
+			Expected: uint8Schema.typ.String(),
+			Actual:   reflectedType.String(),
+		}
+	}
+
+	return uint8Schema.validate(uint8(reflectedValue.Uint()))
+}
 
+func (uint8Schema Uint8Schema) validate(value uint8) error {
 	for _, rule := range uint8Schema.rules {
-		if err := rule(uint8Value); err != nil {
+		if err := rule(value); err != nil {
 			return err
 		}
 	}
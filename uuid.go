@@ -0,0 +1,141 @@
+package gosch
+
+import (
+	"reflect"
+	"slices"
+)
+
+// UUID validate that a string is an RFC 9562 UUID in the 8-4-4-4-12 hexadecimal form, e.g. "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+// The variant must be the RFC 9562 variant and the version must be one of the versions, or any version from 1 to 8 if there are none.
+// It will panic if a version is not between 1 and 8.
+// If the input is not a valid UUID, it will return an error.
+func (stringSchema StringSchema) UUID(versions ...int) StringSchema {
+	return stringSchema.uuid(versions, false)
+}
+
+// CanonicalUUID validate that a string is a UUID like the UUID rule, in its canonical lowercase form.
+// It will panic if a version is not between 1 and 8.
+// If the input is not a valid lowercase UUID, it will return an error.
+func (stringSchema StringSchema) CanonicalUUID(versions ...int) StringSchema {
+	return stringSchema.uuid(versions, true)
+}
+
+func (stringSchema StringSchema) uuid(versions []int, lowercase bool) StringSchema {
+	versions = uuidVersions(versions)
+
+	stringSchema.rules = append(slices.Clip(stringSchema.rules), func(value string) error {
+		uuid, ok := parseUUID(value, lowercase)
+		if !ok || !isUUID(uuid, versions) {
+			return RuleError{
+				Name:   RuleUUID,
+				Value:  value,
+				Params: []any{versions, lowercase},
+			}
+		}
+		return nil
+	})
+
+	return stringSchema
+}
+
+// UUID validate that an array is a binary RFC 9562 UUID, a [16]byte like uuid.UUID of github.com/google/uuid.
+// The variant must be the RFC 9562 variant and the version must be one of the versions, or any version from 1 to 8 if there are none.
+// UUID also set the length of the array to 16.
+// It will panic if a version is not between 1 and 8.
+// If the input is not a valid UUID, it will return an error.
+func (arraySchema ArraySchema) UUID(versions ...int) ArraySchema {
+	versions = uuidVersions(versions)
+
+	arraySchema.length = 16
+	arraySchema.rules = append(slices.Clip(arraySchema.rules), func(value reflect.Value) error {
+		ruleError := RuleError{
+			Name:   RuleUUID,
+			Value:  value,
+			Params: []any{versions, false},
+		}
+
+		if value.Type().Elem().Kind() != reflect.Uint8 {
+			return ruleError
+		}
+
+		var uuid [16]byte
+		for i := range uuid {
+			uuid[i] = byte(value.Index(i).Uint())
+		}
+
+		if !isUUID(uuid, versions) {
+			return ruleError
+		}
+		return nil
+	})
+
+	return arraySchema
+}
+
+func uuidVersions(versions []int) []int {
+	for _, version := range versions {
+		if version < 1 || version > 8 {
+			panic("uuid version must be between 1 and 8")
+		}
+	}
+
+	return slices.Clone(versions)
+}
+
+// parseUUID decode the 8-4-4-4-12 hexadecimal form of a UUID.
+func parseUUID(value string, lowercase bool) ([16]byte, bool) {
+	var uuid [16]byte
+
+	if len(value) != 36 || value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+		return uuid, false
+	}
+
+	i := 0
+	for j := 0; j < len(value); j += 2 {
+		if value[j] == '-' {
+			j--
+			continue
+		}
+
+		high, ok := hexDigit(value[j], lowercase)
+		if !ok {
+			return uuid, false
+		}
+		low, ok := hexDigit(value[j+1], lowercase)
+		if !ok {
+			return uuid, false
+		}
+
+		uuid[i] = high<<4 | low
+		i++
+	}
+
+	return uuid, true
+}
+
+// isUUID check the variant and version bits of a UUID.
+func isUUID(uuid [16]byte, versions []int) bool {
+	if uuid[8]&0xc0 != 0x80 {
+		return false
+	}
+
+	version := int(uuid[6] >> 4)
+	if len(versions) == 0 {
+		return version >= 1 && version <= 8
+	}
+
+	return slices.Contains(versions, version)
+}
+
+func hexDigit(c byte, lowercase bool) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F' && !lowercase:
+		return c - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
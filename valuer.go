@@ -0,0 +1,38 @@
+package gosch
+
+import "database/sql/driver"
+
+type ValuerSchema struct {
+	schema Schema
+}
+
+// Valuer validate the driver value of the input with the schema.
+// An input that implement driver.Valuer, e.g. sql.NullString, is validated through Value, so a NULL is passed to the schema as nil.
+// A nil pointer is passed to the schema as nil and any other input is passed unchanged.
+func Valuer(schema Schema) ValuerSchema {
+	return ValuerSchema{
+		schema: schema,
+	}
+}
+
+func (valuerSchema ValuerSchema) Validate(value any) error {
+	if isNil(value) {
+		return valuerSchema.schema.Validate(nil)
+	}
+
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return valuerSchema.schema.Validate(value)
+	}
+
+	driverValue, err := valuer.Value()
+	if err != nil {
+		return RuleError{
+			Name:   RuleConvert,
+			Value:  value,
+			Params: []any{err},
+		}
+	}
+
+	return valuerSchema.schema.Validate(driverValue)
+}
@@ -0,0 +1,52 @@
+package gosch
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+type testFailingValuer struct{}
+
+func (testFailingValuer) Value() (driver.Value, error) { return nil, errors.New("value failed") }
+
+func TestValuer(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+		value  any
+		valid  bool
+	}{
+		{name: "valid", schema: Valuer(String().MinLength(2)), value: sql.NullString{String: "ok", Valid: true}, valid: true},
+		{name: "invalid", schema: Valuer(String().MinLength(3)), value: sql.NullString{String: "ok", Valid: true}, valid: false},
+		{name: "NULL", schema: Valuer(String()), value: sql.NullString{}, valid: false},
+		{name: "NULL with Nil", schema: Valuer(String().Nil()), value: sql.NullString{}, valid: true},
+		{name: "NullInt64", schema: Valuer(Int64().MinValue(1)), value: sql.NullInt64{Int64: 5, Valid: true}, valid: true},
+		{name: "pointer", schema: Valuer(String()), value: &sql.NullString{String: "ok", Valid: true}, valid: true},
+		{name: "nil pointer", schema: Valuer(String()), value: (*sql.NullString)(nil), valid: false},
+		{name: "nil pointer with Nil", schema: Valuer(String().Nil()), value: (*sql.NullString)(nil), valid: true},
+		{name: "not a Valuer", schema: Valuer(String()), value: "plain", valid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.Validate(test.value)
+			if got := err == nil; got != test.valid {
+				t.Errorf("Validate(%v) = %v, want valid %v", test.value, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestValuerConvertError(t *testing.T) {
+	err := Valuer(String()).Validate(testFailingValuer{})
+
+	ruleError, ok := err.(RuleError)
+	if !ok || ruleError.Name != RuleConvert {
+		t.Fatalf("Validate(testFailingValuer{}) = %v, want a %v error", err, RuleConvert)
+	}
+	if cause, ok := ruleError.Params[0].(error); !ok || cause.Error() != "value failed" {
+		t.Errorf("Validate(testFailingValuer{}) params = %v, want the Value error", ruleError.Params)
+	}
+}